@@ -0,0 +1,292 @@
+package mailck
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"unicode/utf8"
+)
+
+// Address is an email address parsed according to RFC 5321.
+// Internationalized addresses according to RFC 6531 are supported.
+type Address struct {
+	// LocalPart is the part before the @. A quoted local part is contained including the quotes.
+	LocalPart string
+	// Domain is the part after the @. An address literal is contained including the brackets.
+	Domain string
+	// ASCIIDomain is the domain with internationalized labels converted to punycode,
+	// as used for DNS and SMTP. For ASCII domains, it is equal to Domain.
+	ASCIIDomain string
+	// Quoted is true, if the local part is a quoted string like "john doe".
+	Quoted bool
+	// Literal is true, if the domain is an address literal like [192.0.2.1].
+	Literal bool
+}
+
+func (a *Address) String() string {
+	return a.LocalPart + "@" + a.Domain
+}
+
+// IsInternationalized returns true, if the local part contains UTF-8 characters.
+// Such an address can only be delivered by mailservers, which support SMTPUTF8.
+func (a *Address) IsInternationalized() bool {
+	return !isASCII(a.LocalPart)
+}
+
+// SyntaxProfile selects the strictness of the address parser.
+type SyntaxProfile int
+
+const (
+	// PracticalProfile accepts the addresses, which are reasonable in a web form.
+	// In addition to the RFC, it rejects quoted local parts and address literals
+	// and requires a domain with a top level domain of letters.
+	PracticalProfile SyntaxProfile = iota
+	// StrictProfile accepts all addresses, which are valid according to RFC 5321.
+	StrictProfile
+)
+
+// SyntaxReason describes, why an address is syntactically invalid.
+type SyntaxReason string
+
+const (
+	ReasonAddressTooLong        SyntaxReason = "addressTooLong"
+	ReasonMissingAt             SyntaxReason = "missingAt"
+	ReasonEmptyLocalPart        SyntaxReason = "emptyLocalPart"
+	ReasonLocalPartTooLong      SyntaxReason = "localPartTooLong"
+	ReasonInvalidCharacter      SyntaxReason = "invalidCharacter"
+	ReasonInvalidUTF8           SyntaxReason = "invalidUTF8"
+	ReasonLeadingDot            SyntaxReason = "leadingDot"
+	ReasonTrailingDot           SyntaxReason = "trailingDot"
+	ReasonConsecutiveDots       SyntaxReason = "consecutiveDots"
+	ReasonUnterminatedQuote     SyntaxReason = "unterminatedQuote"
+	ReasonQuotedLocalPart       SyntaxReason = "quotedLocalPart"
+	ReasonEmptyDomain           SyntaxReason = "emptyDomain"
+	ReasonDomainTooLong         SyntaxReason = "domainTooLong"
+	ReasonEmptyLabel            SyntaxReason = "emptyLabel"
+	ReasonLabelTooLong          SyntaxReason = "labelTooLong"
+	ReasonInvalidLabel          SyntaxReason = "invalidLabel"
+	ReasonInvalidIDN            SyntaxReason = "invalidIDN"
+	ReasonInvalidAddressLiteral SyntaxReason = "invalidAddressLiteral"
+	ReasonAddressLiteral        SyntaxReason = "addressLiteral"
+	ReasonMissingTopLevelDomain SyntaxReason = "missingTopLevelDomain"
+	ReasonInvalidTopLevelDomain SyntaxReason = "invalidTopLevelDomain"
+)
+
+// SyntaxError is returned by ParseAddress for invalid addresses.
+type SyntaxError struct {
+	Address string
+	Reason  SyntaxReason
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("invalid email address %q: %v", e.Address, e.Reason)
+}
+
+// limits of RFC 5321 section 4.5.3.1 and RFC 3696 errata 1690
+const (
+	maxAddressLength   = 254
+	maxLocalPartLength = 64
+	maxDomainLength    = 253
+	maxLabelLength     = 63
+)
+
+// ParseAddress parses an email address of the form local-part@domain.
+// UTF-8 is allowed in the local part and the domain, internationalized domains are
+// converted to punycode by the rules of IDNA2008.
+// Comments, display names and folding whitespace, as allowed by RFC 5322 in message headers,
+// are not accepted, because they are not part of the address used in the SMTP envelope.
+func ParseAddress(address string, profile SyntaxProfile) (*Address, error) {
+	fail := func(reason SyntaxReason) (*Address, error) {
+		return nil, &SyntaxError{Address: address, Reason: reason}
+	}
+	if len(address) > maxAddressLength {
+		return fail(ReasonAddressTooLong)
+	}
+	if !utf8.ValidString(address) {
+		return fail(ReasonInvalidUTF8)
+	}
+
+	at := strings.LastIndex(address, "@")
+	if at == -1 {
+		return fail(ReasonMissingAt)
+	}
+	a := &Address{LocalPart: address[:at], Domain: address[at+1:]}
+
+	if reason := parseLocalPart(a, profile); reason != "" {
+		return fail(reason)
+	}
+	if reason := parseDomain(a, profile); reason != "" {
+		return fail(reason)
+	}
+	return a, nil
+}
+
+func parseLocalPart(a *Address, profile SyntaxProfile) SyntaxReason {
+	local := a.LocalPart
+	if local == "" {
+		return ReasonEmptyLocalPart
+	}
+	if len(local) > maxLocalPartLength {
+		return ReasonLocalPartTooLong
+	}
+	if local[0] == '"' {
+		a.Quoted = true
+		if profile == PracticalProfile {
+			return ReasonQuotedLocalPart
+		}
+		return parseQuotedString(local)
+	}
+	return parseDotString(local)
+}
+
+// parseDotString checks a local part of the form atom *("." atom).
+func parseDotString(s string) SyntaxReason {
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '.' && i == 0:
+			return ReasonLeadingDot
+		case s[i] == '.' && i == len(s)-1:
+			return ReasonTrailingDot
+		case s[i] == '.' && s[i-1] == '.':
+			return ReasonConsecutiveDots
+		case s[i] != '.' && !isAtext(s[i]):
+			return ReasonInvalidCharacter
+		}
+	}
+	return ""
+}
+
+// parseQuotedString checks a local part of the form DQUOTE *QcontentSMTP DQUOTE.
+func parseQuotedString(s string) SyntaxReason {
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\':
+			i++
+			if i == len(s) || s[i] < 32 || s[i] > 126 {
+				return ReasonInvalidCharacter
+			}
+		case c == '"':
+			if i != len(s)-1 {
+				return ReasonInvalidCharacter
+			}
+			return ""
+		case c < 32 || c == 127:
+			return ReasonInvalidCharacter
+		}
+	}
+	return ReasonUnterminatedQuote
+}
+
+// isAtext returns true for the characters of an atom (RFC 5322, section 3.2.3),
+// including the bytes of UTF-8 sequences (RFC 6532, section 3.2).
+func isAtext(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+		c >= utf8.RuneSelf || strings.IndexByte("!#$%&'*+-/=?^_`{|}~", c) != -1
+}
+
+func parseDomain(a *Address, profile SyntaxProfile) SyntaxReason {
+	domain := a.Domain
+	if domain == "" {
+		return ReasonEmptyDomain
+	}
+	if domain[0] == '[' {
+		a.Literal = true
+		a.ASCIIDomain = domain
+		if profile == PracticalProfile {
+			return ReasonAddressLiteral
+		}
+		if literalIP(domain) == nil {
+			return ReasonInvalidAddressLiteral
+		}
+		return ""
+	}
+
+	if !isASCII(domain) {
+		if strings.Contains(domain, "..") || strings.HasPrefix(domain, ".") || strings.HasSuffix(domain, ".") {
+			return ReasonEmptyLabel
+		}
+		var err error
+		if domain, err = toASCIIDomain(domain); err != nil {
+			return ReasonInvalidIDN
+		}
+	}
+	a.ASCIIDomain = domain
+	if len(domain) > maxDomainLength {
+		return ReasonDomainTooLong
+	}
+
+	labels := strings.Split(domain, ".")
+	for _, label := range labels {
+		if reason := parseLabel(label); reason != "" {
+			return reason
+		}
+	}
+
+	if profile == PracticalProfile {
+		if len(labels) < 2 {
+			return ReasonMissingTopLevelDomain
+		}
+		if !isTopLevelDomain(labels[len(labels)-1]) {
+			return ReasonInvalidTopLevelDomain
+		}
+	}
+	return ""
+}
+
+// parseLabel checks a label of the form Let-dig [Ldh-str].
+func parseLabel(label string) SyntaxReason {
+	if label == "" {
+		return ReasonEmptyLabel
+	}
+	if len(label) > maxLabelLength {
+		return ReasonLabelTooLong
+	}
+	for i := 0; i < len(label); i++ {
+		c := label[i]
+		letDig := 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+		if !letDig && c != '-' {
+			return ReasonInvalidCharacter
+		}
+	}
+	if label[0] == '-' || label[len(label)-1] == '-' {
+		return ReasonInvalidLabel
+	}
+	return ""
+}
+
+// isTopLevelDomain returns true for a label of at least two letters or an IDNA label.
+func isTopLevelDomain(label string) bool {
+	if strings.HasPrefix(strings.ToLower(label), "xn--") {
+		return true
+	}
+	if len(label) < 2 {
+		return false
+	}
+	for i := 0; i < len(label); i++ {
+		if c := label[i]; !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// literalIP returns the ip of an address literal like [192.0.2.1] or [IPv6:2001:db8::1],
+// or nil, if the literal is invalid.
+func literalIP(literal string) net.IP {
+	if !strings.HasPrefix(literal, "[") || !strings.HasSuffix(literal, "]") {
+		return nil
+	}
+	literal = literal[1 : len(literal)-1]
+	if strings.HasPrefix(literal, "IPv6:") {
+		ip := net.ParseIP(literal[len("IPv6:"):])
+		if ip == nil || ip.To4() != nil && !strings.Contains(literal[len("IPv6:"):], ":") {
+			return nil
+		}
+		return ip
+	}
+	ip := net.ParseIP(literal)
+	if ip == nil || ip.To4() == nil || strings.Contains(literal, ":") {
+		return nil
+	}
+	return ip
+}
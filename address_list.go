@@ -0,0 +1,144 @@
+package mailck
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ListRule is the kind of an entry of an AddressList.
+type ListRule string
+
+const (
+	// AddressRule matches an exact address like foo@example.com.
+	AddressRule ListRule = "address"
+	// DomainRule matches all addresses of a domain like example.com.
+	DomainRule ListRule = "domain"
+	// WildcardRule matches all addresses of the subdomains of a domain, written as *.example.com.
+	WildcardRule ListRule = "wildcard"
+	// LocalPartRule matches the local part by a regular expression, written as /^test\+/.
+	// The local part is matched as written in the address, use (?i) for a case insensitive match.
+	LocalPartRule ListRule = "localPart"
+)
+
+// ListMatch describes the entry of the allowlist or denylist, which matched an address.
+type ListMatch struct {
+	// List is "allow" or "deny".
+	List string   `json:"list"`
+	Rule ListRule `json:"rule"`
+	// Entry is the matching entry as written in the list.
+	Entry string `json:"entry"`
+}
+
+// AddressList is an allowlist or denylist of addresses, domains, domain wildcards
+// and regular expressions on the local part.
+type AddressList struct {
+	addresses  map[string]string
+	domains    map[string]string
+	wildcards  map[string]string
+	localParts []*regexp.Regexp
+}
+
+// NewAddressList returns a list of the entries. The kind of an entry is derived from its form:
+// foo@example.com is an AddressRule, example.com a DomainRule, *.example.com a WildcardRule
+// and /regexp/ a LocalPartRule. Addresses and domains are compared case insensitive.
+func NewAddressList(entries ...string) (*AddressList, error) {
+	l := &AddressList{
+		addresses: map[string]string{},
+		domains:   map[string]string{},
+		wildcards: map[string]string{},
+	}
+	for _, entry := range entries {
+		if err := l.add(strings.TrimSpace(entry)); err != nil {
+			return nil, err
+		}
+	}
+	return l, nil
+}
+
+// LoadAddressList returns a list of the entries of the file.
+// The file contains one entry per line. Empty lines and lines starting with # are ignored.
+func LoadAddressList(file string) (*AddressList, error) {
+	entries, err := readListFile(file)
+	if err != nil {
+		return nil, err
+	}
+	l, err := NewAddressList(entries...)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", file, err)
+	}
+	return l, nil
+}
+
+func (l *AddressList) add(entry string) error {
+	switch {
+	case len(entry) > 2 && strings.HasPrefix(entry, "/") && strings.HasSuffix(entry, "/"):
+		rexp, err := regexp.Compile(entry[1 : len(entry)-1])
+		if err != nil {
+			return fmt.Errorf("invalid list entry %q: %v", entry, err)
+		}
+		l.localParts = append(l.localParts, rexp)
+	case strings.Contains(entry, "@"):
+		at := strings.LastIndex(entry, "@")
+		if at == 0 || at == len(entry)-1 {
+			return fmt.Errorf("invalid list entry %q", entry)
+		}
+		l.addresses[normalizeListAddress(entry)] = entry
+	case strings.HasPrefix(entry, "*."):
+		domain, err := normalizeListDomain(entry[2:])
+		if err != nil {
+			return fmt.Errorf("invalid list entry %q: %v", entry, err)
+		}
+		l.wildcards[domain] = entry
+	default:
+		domain, err := normalizeListDomain(entry)
+		if err != nil {
+			return fmt.Errorf("invalid list entry %q: %v", entry, err)
+		}
+		l.domains[domain] = entry
+	}
+	return nil
+}
+
+// Match returns the first matching entry for the address.
+// Exact addresses are checked before domains, wildcards and regular expressions.
+func (l *AddressList) Match(checkEmail string) (ListMatch, bool) {
+	if l == nil {
+		return ListMatch{}, false
+	}
+	normalized := normalizeListAddress(checkEmail)
+	if entry, ok := l.addresses[normalized]; ok {
+		return ListMatch{Rule: AddressRule, Entry: entry}, true
+	}
+	domain := hostname(normalized)
+	if entry, ok := l.domains[domain]; ok {
+		return ListMatch{Rule: DomainRule, Entry: entry}, true
+	}
+	for parent := domain; strings.Contains(parent, "."); {
+		parent = parent[strings.Index(parent, ".")+1:]
+		if entry, ok := l.wildcards[parent]; ok {
+			return ListMatch{Rule: WildcardRule, Entry: entry}, true
+		}
+	}
+	if at := strings.LastIndex(checkEmail, "@"); at != -1 {
+		for _, rexp := range l.localParts {
+			if rexp.MatchString(checkEmail[:at]) {
+				return ListMatch{Rule: LocalPartRule, Entry: "/" + rexp.String() + "/"}, true
+			}
+		}
+	}
+	return ListMatch{}, false
+}
+
+// normalizeListAddress returns the address in lowercase with the domain in punycode.
+func normalizeListAddress(email string) string {
+	return strings.ToLower(asciiAddress(strings.TrimSuffix(email, ".")))
+}
+
+func normalizeListDomain(domain string) (string, error) {
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+	if domain == "" || strings.ContainsAny(domain, " *@/") {
+		return "", fmt.Errorf("invalid domain")
+	}
+	return toASCIIDomain(domain)
+}
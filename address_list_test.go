@@ -0,0 +1,119 @@
+package mailck
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"path/filepath"
+	"testing"
+)
+
+func TestAddressList_Match(t *testing.T) {
+	l, err := NewAddressList(
+		"Boss@Example.com",
+		"partner.example",
+		"*.partner.example",
+		"bücher.example",
+		`/^test\+/`,
+		`/(?i)^noreply$/`,
+	)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		mail  string
+		rule  ListRule
+		entry string
+	}{
+		{"boss@example.com", AddressRule, "Boss@Example.com"},
+		{"BOSS@EXAMPLE.COM", AddressRule, "Boss@Example.com"},
+		{"someone@example.com", "", ""},
+		{"foo@partner.example", DomainRule, "partner.example"},
+		{"foo@Partner.Example", DomainRule, "partner.example"},
+		{"foo@mail.partner.example", WildcardRule, "*.partner.example"},
+		{"foo@a.b.partner.example", WildcardRule, "*.partner.example"},
+		{"foo@notpartner.example", "", ""},
+		{"foo@xn--bcher-kva.example", DomainRule, "bücher.example"},
+		{"foo@bücher.example", DomainRule, "bücher.example"},
+		{"test+1@example.com", LocalPartRule, `/^test\+/`},
+		{"Test+1@example.com", "", ""},
+		{"NoReply@example.com", LocalPartRule, `/(?i)^noreply$/`},
+		{"xxx", "", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.mail, func(t *testing.T) {
+			match, ok := l.Match(test.mail)
+			assert.Equal(t, test.rule != "", ok)
+			assert.Equal(t, ListMatch{Rule: test.rule, Entry: test.entry}, match)
+		})
+	}
+}
+
+func TestAddressList_Nil(t *testing.T) {
+	var l *AddressList
+	_, ok := l.Match("foo@example.com")
+	assert.False(t, ok)
+}
+
+func TestNewAddressList_Errors(t *testing.T) {
+	for _, entry := range []string{"/[unclosed/", "@example.com", "foo@", "*.", "foo bar.com", "exa*mple.com"} {
+		_, err := NewAddressList(entry)
+		assert.Error(t, err, entry)
+	}
+}
+
+func TestLoadAddressList(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "list.txt")
+	writeFile(t, file, "# partners\npartner.example\n\n*.partner.example\n")
+
+	l, err := LoadAddressList(file)
+	assert.NoError(t, err)
+	_, ok := l.Match("foo@mail.partner.example")
+	assert.True(t, ok)
+
+	writeFile(t, file, "/[unclosed/\n")
+	_, err = LoadAddressList(file)
+	assert.Error(t, err)
+
+	_, err = LoadAddressList(filepath.Join(t.TempDir(), "missing.txt"))
+	assert.Error(t, err)
+}
+
+func TestChecker_AllowAndDenylist(t *testing.T) {
+	allowlist, err := NewAddressList("partner.example", "ceo@mailinator.com")
+	assert.NoError(t, err)
+	denylist, err := NewAddressList("blocked@partner.example", "competitor.example")
+	assert.NoError(t, err)
+
+	// no MX records: without the lists, the mailbox check would fail with InvalidDomain
+	checker := NewChecker(WithResolver(NewStaticResolver()), WithAllowlist(allowlist), WithDenylist(denylist))
+
+	report, err := checker.CheckWithReport(context.Background(), "foo@partner.example")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(Valid))
+	assert.Equal(t, &ListMatch{List: "allow", Rule: DomainRule, Entry: "partner.example"}, report.ListMatch)
+
+	report, err = checker.CheckWithReport(context.Background(), "ceo@mailinator.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(Valid))
+	assert.Equal(t, &ListMatch{List: "allow", Rule: AddressRule, Entry: "ceo@mailinator.com"}, report.ListMatch)
+
+	// the denylist is evaluated before the allowlist
+	report, err = checker.CheckWithReport(context.Background(), "blocked@partner.example")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(Denylisted))
+	assert.Equal(t, &ListMatch{List: "deny", Rule: AddressRule, Entry: "blocked@partner.example"}, report.ListMatch)
+
+	report, err = checker.CheckWithReport(context.Background(), "foo@competitor.example")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(Denylisted))
+
+	// the syntax is checked before the lists
+	report, err = checker.CheckWithReport(context.Background(), "foo bar@partner.example")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(InvalidSyntax))
+	assert.Nil(t, report.ListMatch)
+
+	report, err = checker.CheckWithReport(context.Background(), "foo@other.example")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(InvalidDomain))
+	assert.Equal(t, DomainLevel, report.Level)
+}
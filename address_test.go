@@ -0,0 +1,96 @@
+package mailck
+
+import (
+	"context"
+	"github.com/siebenmann/smtpd"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestParseAddress(t *testing.T) {
+	tests := []struct {
+		address   string
+		profile   SyntaxProfile
+		reason    SyntaxReason
+		localPart string
+		domain    string
+	}{
+		{"foo@example.com", PracticalProfile, "", "foo", "example.com"},
+		{"Foo.Bar+tag@sub.example.co.uk", PracticalProfile, "", "Foo.Bar+tag", "sub.example.co.uk"},
+		{"foo@xn--mller-kva.de", PracticalProfile, "", "foo", "xn--mller-kva.de"},
+		{"foo@example.xn--p1ai", PracticalProfile, "", "foo", "example.xn--p1ai"},
+		{"\"foo bar\"@example.com", StrictProfile, "", "\"foo bar\"", "example.com"},
+		{"\"foo\\\"bar@baz\"@example.com", StrictProfile, "", "\"foo\\\"bar@baz\"", "example.com"},
+		{"foo@[192.0.2.1]", StrictProfile, "", "foo", "[192.0.2.1]"},
+		{"foo@[IPv6:2001:db8::1]", StrictProfile, "", "foo", "[IPv6:2001:db8::1]"},
+		{"foo@localhost", StrictProfile, "", "foo", "localhost"},
+		{"foo@example.123", StrictProfile, "", "foo", "example.123"},
+		{"jörg@müller.de", PracticalProfile, "", "jörg", "müller.de"},
+		{"用户@例子.广告", PracticalProfile, "", "用户", "例子.广告"},
+		{"\"jörg müller\"@example.com", StrictProfile, "", "\"jörg müller\"", "example.com"},
+
+		{"", PracticalProfile, ReasonMissingAt, "", ""},
+		{"foo.example.com", StrictProfile, ReasonMissingAt, "", ""},
+		{"@example.com", StrictProfile, ReasonEmptyLocalPart, "", ""},
+		{strings.Repeat("a", 65) + "@example.com", StrictProfile, ReasonLocalPartTooLong, "", ""},
+		{"foo@" + strings.Repeat("a.", 125) + "com", StrictProfile, ReasonAddressTooLong, "", ""},
+		{".foo@example.com", StrictProfile, ReasonLeadingDot, "", ""},
+		{"foo.@example.com", StrictProfile, ReasonTrailingDot, "", ""},
+		{"foo..bar@example.com", StrictProfile, ReasonConsecutiveDots, "", ""},
+		{"foo bar@example.com", StrictProfile, ReasonInvalidCharacter, "", ""},
+		{"foo@bar@example.com", StrictProfile, ReasonInvalidCharacter, "", ""},
+		{"\"foo@example.com", StrictProfile, ReasonUnterminatedQuote, "", ""},
+		{"\"foo\"bar@example.com", StrictProfile, ReasonInvalidCharacter, "", ""},
+		{"\"foo\"@example.com", PracticalProfile, ReasonQuotedLocalPart, "", ""},
+		{"foo@", StrictProfile, ReasonEmptyDomain, "", ""},
+		{"foo@example..com", StrictProfile, ReasonEmptyLabel, "", ""},
+		{"foo@example.com.", StrictProfile, ReasonEmptyLabel, "", ""},
+		{"foo@" + strings.Repeat("a", 64) + ".com", StrictProfile, ReasonLabelTooLong, "", ""},
+		{"foo@-example.com", StrictProfile, ReasonInvalidLabel, "", ""},
+		{"foo@exa_mple.com", StrictProfile, ReasonInvalidCharacter, "", ""},
+		{"foo@[192.0.2.256]", StrictProfile, ReasonInvalidAddressLiteral, "", ""},
+		{"foo@[IPv6:192.0.2.1]", StrictProfile, ReasonInvalidAddressLiteral, "", ""},
+		{"foo@[192.0.2.1]", PracticalProfile, ReasonAddressLiteral, "", ""},
+		{"foo@localhost", PracticalProfile, ReasonMissingTopLevelDomain, "", ""},
+		{"foo@example.123", PracticalProfile, ReasonInvalidTopLevelDomain, "", ""},
+		{"foo@example.c", PracticalProfile, ReasonInvalidTopLevelDomain, "", ""},
+		{"foo\xff@example.com", StrictProfile, ReasonInvalidUTF8, "", ""},
+		{"foo@a\u200db.de", StrictProfile, ReasonInvalidIDN, "", ""},
+		{"foo@ä_b.de", StrictProfile, ReasonInvalidIDN, "", ""},
+		{"foo@müller..de", StrictProfile, ReasonEmptyLabel, "", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.address, func(t *testing.T) {
+			address, err := ParseAddress(test.address, test.profile)
+			if test.reason != "" {
+				if assert.IsType(t, &SyntaxError{}, err) {
+					assert.Equal(t, test.reason, err.(*SyntaxError).Reason)
+				}
+				assert.Nil(t, address)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.localPart, address.LocalPart)
+			assert.Equal(t, test.domain, address.Domain)
+			assert.Equal(t, test.address, address.String())
+		})
+	}
+}
+
+func TestChecker_AddressLiteral(t *testing.T) {
+	dummyServer := NewDummySMTPServer("localhost:2525", smtpd.NOOP, false, 0)
+	defer dummyServer.Close()
+
+	c := NewChecker(WithFromEmail("noreply@mancke.net"), WithSMTPPort(2525), WithSyntaxProfile(StrictProfile),
+		WithResolver(NewStaticResolver()))
+	report, err := c.CheckWithReport(context.Background(), "foo@[127.0.0.1]")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(Valid))
+	assert.Equal(t, MailboxLevel, report.Level)
+
+	report, err = NewChecker().CheckWithReport(context.Background(), "foo@[127.0.0.1]")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(InvalidSyntax))
+	assert.Equal(t, ReasonAddressLiteral, report.SyntaxReason)
+}
@@ -0,0 +1,58 @@
+package mailck
+
+import (
+	"errors"
+	"fmt"
+	"net/textproto"
+	"regexp"
+)
+
+// SenderBlockedError is returned together with the SenderBlocked result.
+// It means, that the mailserver refused to talk to us, e.g. because our ip is on a blocklist.
+type SenderBlockedError struct {
+	// Host is the MX host, which rejected the connection.
+	Host string
+	// Reply is the rejecting reply of the mailserver.
+	Reply Reply
+	// Err is the underlying error, usually an *SMTPError.
+	Err error
+}
+
+func (e *SenderBlockedError) Error() string {
+	return fmt.Sprintf("sender blocked by %v: %v", e.Host, e.Reply)
+}
+
+func (e *SenderBlockedError) Unwrap() error {
+	return e.Err
+}
+
+// blockHintRexp matches the wording of blocklist rejections, e.g. a listing at Spamhaus or a blocked ip.
+// General words like "blocked" or "denied" are not enough, because they are used for policy rejections as well.
+var blockHintRexp = regexp.MustCompile(`(?i)` +
+	`\b(block ?list(ed)?|black ?list(ed)?|listed (at|in|on|by)|spamcop|sorbs|uceprotect|[psx]bl|dnsbl|rbl|dynamic ip)\b` +
+	`|\b(spamhaus|barracuda)` +
+	`|\b(ip|client host|host)\b.{0,40}\bblocked\b` +
+	`|\bblocked\b.{0,20}\b(ip|host)\b` +
+	`|\d{1,3}(\.\d{1,3}){3}\]?.*\bblocked\b`)
+
+// hasBlockHint returns true, if the reply text indicates, that our server is blocked.
+func hasBlockHint(text string) bool {
+	return blockHintRexp.MatchString(text)
+}
+
+// asSenderBlocked returns a SenderBlockedError, if err is a reply of the mailserver,
+// which rejects the connection before the RCPT TO command.
+// A rejection of the greeting with 554 is always treated as a block.
+// Other rejections need a hint of a blocklist in the text, a 5.7.x code alone is a policy rejection.
+func asSenderBlocked(host string, err error, greeting bool) (*SenderBlockedError, bool) {
+	var protoErr *textproto.Error
+	if !errors.As(err, &protoErr) {
+		return nil, false
+	}
+	reply := ParseReply(protoErr.Code, protoErr.Msg)
+	blocked := hasBlockHint(reply.Text) || (greeting && reply.Code == 554)
+	if reply.Code/100 != 4 && reply.Code/100 != 5 || !blocked {
+		return nil, false
+	}
+	return &SenderBlockedError{Host: host, Reply: reply, Err: err}, true
+}
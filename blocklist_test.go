@@ -0,0 +1,49 @@
+package mailck
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/textproto"
+	"testing"
+)
+
+func Test_asSenderBlocked(t *testing.T) {
+	tests := []struct {
+		err      error
+		greeting bool
+		blocked  bool
+	}{
+		{&textproto.Error{Code: 554, Msg: "mx.example.com ESMTP not accepting messages"}, true, true},
+		{&textproto.Error{Code: 554, Msg: "Transaction failed"}, false, false},
+		{&textproto.Error{Code: 550, Msg: "5.7.1 Client host [192.0.2.1] blocked using zen.spamhaus.org; https://www.spamhaus.org/query/ip/192.0.2.1"}, false, true},
+		{&textproto.Error{Code: 550, Msg: "5.7.1 Service unavailable, Client host [192.0.2.1] blocked using Spamhaus PBL"}, false, true},
+		{&textproto.Error{Code: 554, Msg: "Blocked - see http://www.barracudacentral.org/reputation?ip=192.0.2.1"}, false, true},
+		{&textproto.Error{Code: 421, Msg: "4.7.0 [192.0.2.1] Our system has detected an unusual rate of unsolicited mail; blocked"}, false, true},
+		{&textproto.Error{Code: 450, Msg: "4.7.1 Client host rejected: blocked using zen.spamhaus.org"}, false, true},
+		{&textproto.Error{Code: 550, Msg: "5.7.1 Your IP 192.0.2.1 is listed in the RBL"}, false, true},
+		{&textproto.Error{Code: 554, Msg: "5.7.1 Service unavailable; IP address blocked"}, false, true},
+		// policy rejections without a reference to a blocklist
+		{&textproto.Error{Code: 550, Msg: "5.7.1 Access denied"}, false, false},
+		{&textproto.Error{Code: 550, Msg: "5.7.1 Message blocked due to its content"}, false, false},
+		{&textproto.Error{Code: 550, Msg: "5.7.1 Sender has a poor reputation"}, false, false},
+		{&textproto.Error{Code: 550, Msg: "Not accepted"}, false, false},
+		{&textproto.Error{Code: 451, Msg: "Greylisted"}, false, false},
+		{errors.New("connection reset"), true, false},
+		{nil, true, false},
+	}
+	for _, test := range tests {
+		t.Run(func() string {
+			if test.err == nil {
+				return "nil"
+			}
+			return test.err.Error()
+		}(), func(t *testing.T) {
+			blockedErr, ok := asSenderBlocked("mx.example.com", test.err, test.greeting)
+			assert.Equal(t, test.blocked, ok)
+			if ok {
+				assert.Equal(t, "mx.example.com", blockedErr.Host)
+				assert.Contains(t, blockedErr.Error(), "mx.example.com")
+			}
+		})
+	}
+}
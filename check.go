@@ -2,171 +2,542 @@ package mailck
 
 import (
 	"context"
-	"fmt"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"net"
-	"net/smtp"
-	"regexp"
+	"net/textproto"
+	"strconv"
 	"strings"
+	"time"
 )
 
-var emailRexp = regexp.MustCompile("^[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\\.[A-Za-z]{2,64}$")
-
 // Check checks the syntax and if valid, it checks the mailbox by connecting to
 // the target mailserver
 // The fromEmail is used as from address in the communication to the foreign mailserver.
 func Check(fromEmail, checkEmail string) (result Result, err error) {
-	if !CheckSyntax(checkEmail) {
-		return InvalidSyntax, nil
+	return defaultChecker().withFromEmail(fromEmail).Check(checkEmail)
+}
+
+func CheckWithContext(ctx context.Context, fromEmail, checkEmail string) (result Result, err error) {
+	return defaultChecker().withFromEmail(fromEmail).CheckWithContext(ctx, checkEmail)
+}
+
+// CheckSyntax returns true for a valid email, false otherwise.
+// The address is checked with the PracticalProfile, use ParseAddress for other profiles.
+func CheckSyntax(checkEmail string) bool {
+	_, err := ParseAddress(checkEmail, PracticalProfile)
+	return err == nil
+}
+
+// CheckMailbox checks the checkEmail by connecting to the target mailbox and returns the result.
+// The fromEmail is used as from address in the communication to the foreign mailserver.
+func CheckMailbox(fromEmail, checkEmail string) (result Result, err error) {
+	return defaultChecker().withFromEmail(fromEmail).CheckMailbox(checkEmail)
+}
+
+func CheckMailboxWithContext(ctx context.Context, fromEmail, checkEmail string) (result Result, err error) {
+	return defaultChecker().withFromEmail(fromEmail).CheckMailboxWithContext(ctx, checkEmail)
+}
+
+// Check performs the enabled checks on checkEmail.
+// By default, it checks the syntax and if valid, it checks the mailbox by connecting to
+// the target mailserver.
+func (c *Checker) Check(checkEmail string) (result Result, err error) {
+	return c.CheckWithContext(context.Background(), checkEmail)
+}
+
+// CheckWithContext is like Check, but stops when the context is done.
+func (c *Checker) CheckWithContext(ctx context.Context, checkEmail string) (result Result, err error) {
+	return c.check(ctx, checkEmail, newRecorder(checkEmail))
+}
+
+// CheckWithReport is like CheckWithContext, but returns a report with the details of the check,
+// e.g. the SMTP transcript. The report is also returned, if the check fails with an error.
+func (c *Checker) CheckWithReport(ctx context.Context, checkEmail string) (*CheckReport, error) {
+	rec := newRecorder(checkEmail)
+	result, err := c.check(ctx, checkEmail, rec)
+	return rec.finish(result, err), err
+}
+
+func (c *Checker) check(ctx context.Context, checkEmail string, rec *recorder) (result Result, err error) {
+	if c.err != nil {
+		return ServiceError, c.err
 	}
 
-	if CheckDisposable(checkEmail) {
-		return Disposable, nil
+	result, err = c.checkStages(ctx, checkEmail, rec)
+	rec.details(func(d *Details) {
+		// a mailbox accepted by the mailserver needs no correction
+		if suggestion, ok := Suggest(checkEmail); ok && result != Valid {
+			d.Suggestion = suggestion
+		}
+		d.Role = c.isRole(checkEmail)
+		d.FreeProvider = CheckFreeProvider(checkEmail)
+	})
+	return result, err
+}
+
+// checkStages performs the stages of the pipeline.
+func (c *Checker) checkStages(ctx context.Context, checkEmail string, rec *recorder) (result Result, err error) {
+	report := &Report{Email: checkEmail, checker: c, rec: rec}
+	result, decided, err := c.pipeline.run(ctx, stageAddress(checkEmail), report)
+	rec.details(func(d *Details) {
+		d.Level = report.Level
+	})
+	if !decided {
+		result = validAtLevel(report.Level)
 	}
-	return CheckMailbox(fromEmail, checkEmail)
+	return result, err
 }
 
-func CheckWithContext(ctx context.Context, fromEmail, checkEmail string) (result Result, err error) {
-	if !CheckSyntax(checkEmail) {
-		return InvalidSyntax, nil
+// validAtLevel replaces the detail of the valid result of a pipeline without decision by the level,
+// which was reached, e.g. DomainChecked, if the mailbox was not checked.
+// Valid results decided by a stage, e.g. by the allowlist, are kept.
+func validAtLevel(level Level) Result {
+	switch level {
+	case MailboxLevel:
+		return Valid
+	case DomainLevel:
+		return DomainChecked
 	}
+	return SyntaxChecked
+}
 
-	if CheckDisposable(checkEmail) {
-		return Disposable, nil
+func syntaxStage(ctx context.Context, c *Checker, address *Address, report *Report) (Decision, error) {
+	if !c.checks.Has(SyntaxCheck) {
+		return Continue, nil
 	}
-	return CheckMailboxWithContext(ctx, fromEmail, checkEmail)
+	report.Level = SyntaxLevel
+	if _, err := ParseAddress(report.Email, c.syntaxProfile); err != nil {
+		report.rec.details(func(d *Details) {
+			d.SyntaxReason = err.(*SyntaxError).Reason
+		})
+		return Decide(InvalidSyntax), nil
+	}
+	return Continue, nil
 }
 
-// CheckSyntax returns true for a valid email, false otherwise
-func CheckSyntax(checkEmail string) bool {
-	return emailRexp.Match([]byte(checkEmail))
+func denylistStage(ctx context.Context, c *Checker, address *Address, report *Report) (Decision, error) {
+	if match, ok := c.denylist.Match(report.Email); ok {
+		return listDecision(report, Denylisted, "deny", match), nil
+	}
+	return Continue, nil
 }
 
-var noContext = context.Background()
-var defaultResolver = net.DefaultResolver
-var defaultDialer = net.Dialer{}
+func allowlistStage(ctx context.Context, c *Checker, address *Address, report *Report) (Decision, error) {
+	if match, ok := c.allowlist.Match(report.Email); ok {
+		return listDecision(report, Valid, "allow", match), nil
+	}
+	return Continue, nil
+}
+
+func disposableStage(ctx context.Context, c *Checker, address *Address, report *Report) (Decision, error) {
+	if !c.checks.Has(DisposableCheck) {
+		return Continue, nil
+	}
+	if match, ok := c.matchDisposable(report.Email); ok {
+		return disposableDecision(report, match), nil
+	}
+	return Continue, nil
+}
+
+func roleStage(ctx context.Context, c *Checker, address *Address, report *Report) (Decision, error) {
+	if c.rejectRoles && c.isRole(report.Email) {
+		return Decide(RoleAccount), nil
+	}
+	return Continue, nil
+}
+
+func freeProviderStage(ctx context.Context, c *Checker, address *Address, report *Report) (Decision, error) {
+	if c.rejectFree && CheckFreeProvider(report.Email) {
+		return Decide(FreeMail), nil
+	}
+	return Continue, nil
+}
+
+func domainStage(ctx context.Context, c *Checker, address *Address, report *Report) (Decision, error) {
+	if c.level.depth() < DomainLevel.depth() || !c.checks.Has(MailboxCheck) && !c.disposableMXCheck(c.checks) {
+		return Continue, nil
+	}
+	if decision, err := c.lookup(ctx, report); decision.Decided {
+		return decision, err
+	}
+	if c.disposableMXCheck(c.checks) {
+		if match, ok := matchDisposableMX(report.MXList); ok {
+			return disposableDecision(report, match), nil
+		}
+	}
+	return Continue, nil
+}
+
+func mailboxStage(ctx context.Context, c *Checker, address *Address, report *Report) (Decision, error) {
+	if c.level.depth() < MailboxLevel.depth() || !c.checks.Has(MailboxCheck) {
+		return Continue, nil
+	}
+	result, err := c.checkMailboxOfReport(ctx, report)
+	return Decide(result), err
+}
+
+// matchDisposable checks the address against the configured or the embedded disposable domains.
+func (c *Checker) matchDisposable(checkEmail string) (DisposableMatch, bool) {
+	if c.disposable != nil {
+		return c.disposable.MatchDisposable(checkEmail)
+	}
+	return MatchDisposable(checkEmail)
+}
+
+// disposableMXCheck returns true, if the MX hosts have to be checked for disposable mail services.
+func (c *Checker) disposableMXCheck(checks Checks) bool {
+	return c.disposableMX && checks.Has(DisposableCheck)
+}
+
+// listDecision decides the result of a list and reports the matching entry.
+func listDecision(report *Report, result Result, list string, match ListMatch) Decision {
+	match.List = list
+	report.rec.details(func(d *Details) {
+		d.ListMatch = &match
+	})
+	return Decide(result)
+}
+
+// disposableDecision decides Disposable and reports the matching rule.
+func disposableDecision(report *Report, match DisposableMatch) Decision {
+	report.rec.details(func(d *Details) {
+		d.DisposableMatch = &match
+	})
+	return Decide(Disposable)
+}
 
 // CheckMailbox checks the checkEmail by connecting to the target mailbox and returns the result.
-// The fromEmail is used as from address in the communication to the foreign mailserver.
-func CheckMailbox(fromEmail, checkEmail string) (result Result, err error) {
-	mxList, err := net.LookupMX(hostname(checkEmail))
-	// TODO: Distinguish between usual network errors
-	if err != nil || len(mxList) == 0 {
-		return InvalidDomain, nil
+func (c *Checker) CheckMailbox(checkEmail string) (result Result, err error) {
+	return c.CheckMailboxWithContext(context.Background(), checkEmail)
+}
+
+// CheckMailboxWithContext is like CheckMailbox, but stops when the context is done.
+// If the domain has no MX records, the mailbox is checked at the implicit MX.
+func (c *Checker) CheckMailboxWithContext(ctx context.Context, checkEmail string) (result Result, err error) {
+	if c.err != nil {
+		return ServiceError, c.err
 	}
-	return checkMailbox(noContext, fromEmail, checkEmail, mxList, 25)
+	return c.checkMailboxOfReport(ctx, &Report{Email: checkEmail, checker: c, rec: newRecorder(checkEmail)})
 }
 
-func CheckMailboxWithContext(ctx context.Context, fromEmail, checkEmail string) (result Result, err error) {
-	mxList, err := defaultResolver.LookupMX(ctx, hostname(checkEmail))
-	// TODO: Distinguish between usual network errors
+// lookup looks up the MX hosts of the domain, if not done before, and stores them in the report.
+// If the domain has no usable mailserver, the decision contains the result.
+func (c *Checker) lookup(ctx context.Context, report *Report) (Decision, error) {
+	if report.MXList != nil {
+		return Continue, nil
+	}
+	// DNS and SMTP need the punycode form of internationalized domains
+	domain := hostname(asciiAddress(report.Email))
+	start := time.Now()
+	mxList, implicit, err := c.lookupMX(ctx, domain)
+	report.rec.timing(PhaseLookup, "", start)
+	if isTimeout(err) {
+		report.rec.details(func(d *Details) {
+			d.TimeoutPhase = PhaseLookup
+		})
+		return Decide(TimeoutError), &DNSError{Domain: domain, Err: err}
+	}
+	if err == nil || err == errNullMX || isNotFound(err) {
+		// the DNS gave a definite answer
+		report.Level = DomainLevel
+	}
+	if err == errNullMX {
+		return Decide(DomainAcceptsNoMail), nil
+	}
+	if err != nil && !isNotFound(err) {
+		// e.g. a SERVFAIL: the domain may exist
+		return Decide(DNSFailure), &DNSError{Domain: domain, Err: err}
+	}
 	if err != nil || len(mxList) == 0 {
-		return InvalidDomain, nil
+		return Decide(InvalidDomain), nil
+	}
+	report.rec.mxRecords(mxList)
+	report.rec.details(func(d *Details) {
+		d.ImplicitMX = implicit
+	})
+	report.MXList, report.ImplicitMX = mxList, implicit
+	return Continue, nil
+}
+
+// checkMailboxOfReport checks the mailbox at the mailservers of the report, which are looked up, if needed.
+func (c *Checker) checkMailboxOfReport(ctx context.Context, report *Report) (result Result, err error) {
+	if decision, err := c.lookup(ctx, report); decision.Decided {
+		return decision.Result, err
+	}
+	result, err = c.checkMailbox(ctx, asciiAddress(report.Email), report.MXList, report.rec)
+	if !result.IsError() {
+		report.Level = MailboxLevel
 	}
-	return checkMailbox(ctx, fromEmail, checkEmail, mxList, 25)
+	return result, err
 }
 
 type checkRv struct {
 	res Result
 	err error
+	// failover is true, if the next MX should be tried
+	failover bool
+	// timeoutPhase is the phase, which timed out, if res is the TimeoutError
+	timeoutPhase Phase
+	tls          *TLSInfo
 }
 
-func checkMailbox(ctx context.Context, fromEmail, checkEmail string, mxList []*net.MX, port int) (result Result, err error) {
-	// try to connect to one mx
-	var c *smtp.Client
-	for _, mx := range mxList {
-		var conn net.Conn
-		conn, err = defaultDialer.DialContext(ctx, "tcp", fmt.Sprintf("%v:%v", mx.Host, port))
-		if t, ok := err.(*net.OpError); ok {
-			if t.Timeout() {
-				return TimeoutError, err
-			}
-			return NetworkError, err
-		} else if err != nil {
-			return MailserverError, err
+func (c *Checker) checkMailbox(ctx context.Context, checkEmail string, mxList []*net.MX, rec *recorder) (result Result, err error) {
+	for attempt := 0; ; attempt++ {
+		result, err = c.checkMailboxOnce(ctx, checkEmail, mxList, rec)
+		var tempErr *TemporaryError
+		if !errors.As(err, &tempErr) || !c.retry.waitForRetry(ctx, attempt, tempErr) {
+			return result, err
 		}
-		c, err = smtp.NewClient(conn, mx.Host)
-		if err == nil {
+	}
+}
+
+// checkMailboxOnce walks the MX list by preference, until one mailserver gives an answer.
+// Connect errors, timeouts, failures of the greeting and 421 replies lead to the next MX.
+func (c *Checker) checkMailboxOnce(ctx context.Context, checkEmail string, mxList []*net.MX, rec *recorder) (result Result, err error) {
+	var skipped []SkippedMX
+	var rv checkRv
+	var answered string
+	for _, mx := range mxList {
+		rv = c.checkMX(ctx, checkEmail, mx.Host, rec)
+		if !rv.failover || ctx.Err() != nil {
+			rec.host(mx.Host)
+			answered = mx.Host
 			break
 		}
+		skipped = append(skipped, SkippedMX{Host: mx.Host, Reason: rv.err.Error()})
 	}
-	if err != nil {
-		return MailserverError, err
+	var failover *MXFailover
+	if len(skipped) > 0 {
+		failover = &MXFailover{Host: answered, Skipped: skipped}
 	}
-	if c == nil {
-		// just to get very sure, that we have a connection
-		// this code line should never be reached!
-		return MailserverError, fmt.Errorf("can't obtain connection for %v", checkEmail)
+	rec.details(func(d *Details) {
+		d.TLS, d.TimeoutPhase, d.MXFailover = rv.tls, rv.timeoutPhase, failover
+	})
+	return rv.res, rv.err
+}
+
+// checkMX checks the mailbox at one mailserver.
+func (c *Checker) checkMX(ctx context.Context, checkEmail, host string, rec *recorder) checkRv {
+	start := time.Now()
+	dialCtx, cancel := withTimeout(ctx, c.timeouts.Connect)
+	conn, addr, err := c.dial(dialCtx, host)
+	cancel()
+	rec.timing(PhaseConnect, host, start)
+	if err != nil {
+		dialErr := &DialError{Host: host, Addr: addr, Err: err}
+		if isTimeout(err) {
+			return checkRv{res: TimeoutError, err: dialErr, failover: true, timeoutPhase: PhaseConnect}
+		}
+		var opErr *net.OpError
+		var dnsErr *net.DNSError
+		if errors.As(err, &opErr) || errors.As(err, &dnsErr) {
+			return checkRv{res: NetworkError, err: dialErr, failover: true}
+		}
+		return checkRv{res: MailserverError, err: dialErr, failover: true}
 	}
 
+	smtpCtx, cancel := withTimeout(ctx, c.timeouts.SMTP)
+	defer cancel()
+
 	resChan := make(chan checkRv, 1)
 
 	go func() {
-		defer c.Close()
-		defer c.Quit() // defer ist LIFO
+		var tlsInfo *TLSInfo
+		send := func(result Result, err error) {
+			rv := checkRv{res: result, err: err, failover: isServiceClosing(err), tls: tlsInfo}
+			if phase, ok := timeoutPhase(err); ok {
+				rv.res, rv.failover, rv.timeoutPhase = TimeoutError, true, phase
+			}
+			resChan <- rv
+		}
+
+		// greeting
+		start := time.Now()
+		conn.SetDeadline(phaseDeadline(smtpCtx, c.timeouts.Banner))
+		client, err := newSMTPClient(conn, host, rec)
+		rec.timing(PhaseGreeting, host, start)
+		if err != nil {
+			if blockedErr, ok := asSenderBlocked(host, err, true); ok {
+				resChan <- checkRv{res: SenderBlocked, err: blockedErr, failover: true}
+			} else if tempErr, ok := asTemporaryError(err); ok {
+				resChan <- checkRv{res: TemporaryFailure, err: tempErr, failover: true}
+			} else if phase, ok := timeoutPhase(err); ok {
+				resChan <- checkRv{res: TimeoutError, err: err, failover: true, timeoutPhase: phase}
+			} else {
+				resChan <- checkRv{res: MailserverError, err: err, failover: true}
+			}
+			return
+		}
+		defer client.close()
+		defer client.quit() // defer ist LIFO
+
 		// HELO
-		// err = c.Hello(hostname(fromEmail))
-		err = c.Hello(singleMX(fromEmail))
+		start = time.Now()
+		client.setDeadline(phaseDeadline(smtpCtx, c.timeouts.Helo))
+		err = client.hello(c.helo)
+		rec.timing(PhaseHelo, host, start)
+		if blockedErr, ok := asSenderBlocked(host, err, false); ok {
+			send(SenderBlocked, blockedErr)
+			return
+		}
 		if err != nil {
-			resChan <- checkRv{MailserverError, err}
+			send(MailserverError, err)
 			return
 		}
 
+		// STARTTLS
+		if c.tlsMode != TLSDisabled {
+			start = time.Now()
+			client.setDeadline(phaseDeadline(smtpCtx, c.timeouts.Helo))
+			tlsInfo, err = c.startTLS(client, host)
+			rec.timing(PhaseStartTLS, host, start)
+			if err != nil {
+				send(TLSError, err)
+				return
+			}
+		}
+
+		// SMTPUTF8
+		var mailParams []string
+		if needsSMTPUTF8(checkEmail) {
+			if ok, _ := client.extension("SMTPUTF8"); !ok {
+				send(SMTPUTF8Unsupported, nil)
+				return
+			}
+			mailParams = append(mailParams, "SMTPUTF8")
+		}
+
 		// MAIL FROM
-		err = c.Mail(fromEmail)
+		start = time.Now()
+		client.setDeadline(phaseDeadline(smtpCtx, c.timeouts.MailFrom))
+		err = client.mail(c.fromEmail, mailParams...)
+		rec.timing(PhaseMailFrom, host, start)
+		if blockedErr, ok := asSenderBlocked(host, err, false); ok {
+			send(SenderBlocked, blockedErr)
+			return
+		}
+		if tempErr, ok := asTemporaryError(err); ok {
+			send(TemporaryFailure, tempErr)
+			return
+		}
 		if err != nil {
-			resChan <- checkRv{MailserverError, err}
+			send(MailserverError, err)
 			return
 		}
 
 		// RCPT TO
-		id, err := c.Text.Cmd("RCPT TO:<%s>", checkEmail)
-		if err != nil {
-			resChan <- checkRv{MailserverError, err}
+		start = time.Now()
+		client.setDeadline(phaseDeadline(smtpCtx, c.timeouts.RcptTo))
+		code, msg, err := client.rcpt(checkEmail)
+		rec.timing(PhaseRcptTo, host, start)
+		if err != nil && code == 0 {
+			send(MailserverError, err)
 			return
 		}
-		c.Text.StartResponse(id)
-		code, _, err := c.Text.ReadResponse(25)
-		c.Text.EndResponse(id)
-		if code == 550 {
-			resChan <- checkRv{MailboxUnavailable, nil}
+
+		if result := ClassifyReply(code, msg); result != Valid {
+			if tempErr, ok := asTemporaryError(err); ok && result == TemporaryFailure {
+				err = tempErr
+			} else if result == SenderBlocked {
+				err = &SenderBlockedError{Host: host, Reply: ParseReply(code, msg), Err: err}
+			} else if !result.IsError() {
+				err = nil
+			}
+			send(result, err)
 			return
 		}
 
-		if err != nil {
-			resChan <- checkRv{MailserverError, err}
-			return
+		if c.acceptAll {
+			// RCPT TO for an address, which should not exist
+			probe, err := randomAddress(hostname(checkEmail))
+			if err != nil {
+				send(ServiceError, err)
+				return
+			}
+			client.setDeadline(phaseDeadline(smtpCtx, c.timeouts.RcptTo))
+			code, _, err = client.rcpt(probe)
+			if err == nil && code/100 == 2 {
+				send(AcceptAll, nil)
+				return
+			}
 		}
 
-		resChan <- checkRv{Valid, nil}
+		// the QUIT command is limited by the deadline of the last command
+		send(Valid, nil)
 
 	}()
 	select {
-	case <-ctx.Done():
-		return TimeoutError, ctx.Err()
+	case <-smtpCtx.Done():
+		// unblock the conversation and wait for the phase, in which it stopped
+		conn.Close()
+		q := <-resChan
+		if q.err == nil {
+			return q
+		}
+		stage := Phase("")
+		var smtpErr *SMTPError
+		if errors.As(q.err, &smtpErr) {
+			stage = smtpErr.Stage
+		}
+		return checkRv{res: TimeoutError, err: newSMTPError(host, stage, smtpCtx.Err()), failover: true, timeoutPhase: stage}
 	case q := <-resChan:
-		return q.res, q.err
+		return q
 	}
 }
 
-func hostname(mail string) string {
-	return mail[strings.Index(mail, "@")+1:]
+// dial connects to the mailserver. The addresses of the host are looked up by the resolver of the checker
+// and tried in order, until one connects. It returns the last dialed address.
+func (c *Checker) dial(ctx context.Context, host string) (net.Conn, string, error) {
+	port := strconv.Itoa(c.smtpPort)
+	ips := []string{host}
+	if net.ParseIP(host) == nil {
+		var err error
+		if ips, err = c.resolver.LookupHost(ctx, host); err != nil {
+			return nil, net.JoinHostPort(host, port), err
+		}
+		if len(ips) == 0 {
+			return nil, net.JoinHostPort(host, port), notFound(host)
+		}
+	}
+	var addr string
+	var err error
+	for _, ip := range ips {
+		addr = net.JoinHostPort(ip, port)
+		var conn net.Conn
+		if conn, err = c.dialer.DialContext(ctx, "tcp", addr); err == nil || ctx.Err() != nil {
+			return conn, addr, err
+		}
+	}
+	return nil, addr, err
 }
 
-func singleMX(email string) string {
-
-	var (
-		myList string
-		mxLength int
-	)
+// timeoutPhase returns the phase, in which err timed out.
+func timeoutPhase(err error) (Phase, bool) {
+	var smtpErr *SMTPError
+	if !errors.As(err, &smtpErr) || !isTimeout(smtpErr.Err) {
+		return "", false
+	}
+	return smtpErr.Stage, true
+}
 
-	domain := email[strings.Index(email, "@")+1:]
-	mxrecords, _ := net.LookupMX(domain)	
-	
-	for _, mx := range mxrecords {
+// isServiceClosing returns true for a 421 reply, which means that the mailserver closes the connection.
+func isServiceClosing(err error) bool {
+	var protoErr *textproto.Error
+	return errors.As(err, &protoErr) && protoErr.Code == 421
+}
 
-		myList = mx.Host
-		mxLength = len(myList) - 1
+// randomAddress returns an address of the domain with a random local part,
+// which is very unlikely to exist.
+func randomAddress(domain string) (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
 	}
-	
-	return myList[:mxLength]
-}
\ No newline at end of file
+	return "mailck-" + hex.EncodeToString(b) + "@" + domain, nil
+}
+
+func hostname(mail string) string {
+	return mail[strings.LastIndex(mail, "@")+1:]
+}
@@ -1,11 +1,91 @@
 package mailck
 
 import (
+	"golang.org/x/net/publicsuffix"
+	"net"
 	"strings"
 )
 
+//go:generate go run ./gendisposable -allowlist disposable_allowlist.txt -o disposable_list.go disposable_sources
+
+// DisposableRule is the kind of rule, which classified an address as disposable.
+type DisposableRule string
+
+const (
+	// DisposableDomainRule matches, if the domain is contained in the list of disposable domains.
+	DisposableDomainRule DisposableRule = "domain"
+	// DisposableParentDomainRule matches, if a parent domain is contained in the list,
+	// e.g. mailinator.com for foo@x.mailinator.com.
+	DisposableParentDomainRule DisposableRule = "parentDomain"
+	// DisposableMXRule matches, if an MX host of the domain belongs to the DisposableMXDomains.
+	DisposableMXRule DisposableRule = "mx"
+)
+
+// DisposableMatch describes, which rule classified an address as disposable.
+type DisposableMatch struct {
+	Rule DisposableRule `json:"rule"`
+	// Domain is the matching entry of the list.
+	Domain string `json:"domain"`
+	// MXHost is the matching mailserver. It is only set for the DisposableMXRule.
+	MXHost string `json:"mxHost,omitempty"`
+}
+
+// DisposableMXDomains contains the domains of mail infrastructure of disposable mail services.
+// Domains with an MX host in one of these domains, e.g. mail.mailinator.com, are disposable,
+// if the check is enabled by WithDisposableMXCheck.
+// The map may be extended before the first check.
+var DisposableMXDomains = map[string]bool{
+	"mailinator.com":    true,
+	"guerrillamail.com": true,
+	"yopmail.com":       true,
+	"maildrop.cc":       true,
+	"mailnesia.com":     true,
+	"getnada.com":       true,
+	"temp-mail.org":     true,
+	"trashmail.com":     true,
+	"dropmail.me":       true,
+	"10minutemail.com":  true,
+}
+
 // CheckDisposable returns true if the mail is a disposal mail, false otherwise
 func CheckDisposable(checkEmail string) bool {
-	host := strings.ToLower(hostname(checkEmail))
-	return DisposableDomains[host]
+	_, ok := MatchDisposable(checkEmail)
+	return ok
+}
+
+// MatchDisposable returns the matching rule, if the domain of the mail or one of its parent domains
+// is a disposable domain.
+func MatchDisposable(checkEmail string) (DisposableMatch, bool) {
+	return matchDomain(DisposableDomains, hostname(asciiAddress(checkEmail)))
+}
+
+// matchDomain looks up the domain and its parent domains up to the registered domain,
+// so that entries of the public suffix list like co.uk or github.io never match subdomains.
+func matchDomain(domains map[string]bool, domain string) (DisposableMatch, bool) {
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+	if domains[domain] {
+		return DisposableMatch{Rule: DisposableDomainRule, Domain: domain}, true
+	}
+	registered, err := publicsuffix.EffectiveTLDPlusOne(domain)
+	if err != nil {
+		return DisposableMatch{}, false
+	}
+	for parent := domain; parent != registered; {
+		parent = parent[strings.Index(parent, ".")+1:]
+		if domains[parent] {
+			return DisposableMatch{Rule: DisposableParentDomainRule, Domain: parent}, true
+		}
+	}
+	return DisposableMatch{}, false
+}
+
+// matchDisposableMX returns the first MX host, which belongs to the DisposableMXDomains.
+func matchDisposableMX(mxList []*net.MX) (DisposableMatch, bool) {
+	for _, mx := range mxList {
+		host := strings.TrimSuffix(strings.ToLower(mx.Host), ".")
+		if match, ok := matchDomain(DisposableMXDomains, host); ok {
+			return DisposableMatch{Rule: DisposableMXRule, Domain: match.Domain, MXHost: host}, true
+		}
+	}
+	return DisposableMatch{}, false
 }
@@ -1,11 +1,96 @@
 package mailck
 
 import (
+	"context"
 	"github.com/stretchr/testify/assert"
+	"net"
 	"testing"
 )
 
 func TestCheckDisposable(t *testing.T) {
 	assert.False(t, CheckDisposable("sebastian@mancke.net"))
 	assert.True(t, CheckDisposable("foo@mailinator.com"))
+	assert.True(t, CheckDisposable("foo@x.y.Mailinator.com"))
+	assert.False(t, CheckDisposable("foo@notreallymailinator.com"))
+	// from disposable_sources/mailck.txt
+	assert.True(t, CheckDisposable("foo@1secmail.com"))
+}
+
+func TestMatchDisposable(t *testing.T) {
+	match, ok := MatchDisposable("foo@mailinator.com")
+	assert.True(t, ok)
+	assert.Equal(t, DisposableMatch{Rule: DisposableDomainRule, Domain: "mailinator.com"}, match)
+
+	match, ok = MatchDisposable("foo@x.mailinator.com")
+	assert.True(t, ok)
+	assert.Equal(t, DisposableMatch{Rule: DisposableParentDomainRule, Domain: "mailinator.com"}, match)
+
+	_, ok = MatchDisposable("foo@example.com")
+	assert.False(t, ok)
+}
+
+func Test_matchDomain(t *testing.T) {
+	domains := map[string]bool{"throwaway.example.com": true, "co.uk": true, "github.io": true, "example.net": true}
+	tests := []struct {
+		domain string
+		match  string
+		rule   DisposableRule
+	}{
+		{"throwaway.example.com", "throwaway.example.com", DisposableDomainRule},
+		{"THROWAWAY.example.com.", "throwaway.example.com", DisposableDomainRule},
+		{"x.throwaway.example.com", "throwaway.example.com", DisposableParentDomainRule},
+		{"example.com", "", ""},
+		{"other.example.com", "", ""},
+		{"a.b.example.net", "example.net", DisposableParentDomainRule},
+		// public suffixes never match their subdomains
+		{"example.co.uk", "", ""},
+		{"someone.github.io", "", ""},
+		{"co.uk", "co.uk", DisposableDomainRule},
+		{"[192.0.2.1]", "", ""},
+		{"", "", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.domain, func(t *testing.T) {
+			match, ok := matchDomain(domains, test.domain)
+			assert.Equal(t, test.match != "", ok)
+			assert.Equal(t, test.match, match.Domain)
+			assert.Equal(t, test.rule, match.Rule)
+		})
+	}
+}
+
+func Test_matchDisposableMX(t *testing.T) {
+	match, ok := matchDisposableMX([]*net.MX{{Host: "mx.example.com."}, {Host: "Mail2.Mailinator.com."}})
+	assert.True(t, ok)
+	assert.Equal(t, DisposableMatch{Rule: DisposableMXRule, Domain: "mailinator.com", MXHost: "mail2.mailinator.com"}, match)
+
+	_, ok = matchDisposableMX([]*net.MX{{Host: "mx.example.com."}})
+	assert.False(t, ok)
+}
+
+func TestChecker_DisposableMXCheck(t *testing.T) {
+	resolver := NewStaticResolver().
+		AddMX("fresh-throwaway.example", "mail.mailinator.com.").
+		AddMX("example.com", "mx.example.com.")
+
+	checker := NewChecker(WithChecks(SyntaxCheck|DisposableCheck), WithResolver(resolver), WithDisposableMXCheck(true))
+	report, err := checker.CheckWithReport(context.Background(), "foo@fresh-throwaway.example")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(Disposable))
+	assert.Equal(t, &DisposableMatch{Rule: DisposableMXRule, Domain: "mailinator.com", MXHost: "mail.mailinator.com"}, report.DisposableMatch)
+
+	report, err = checker.CheckWithReport(context.Background(), "foo@example.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(DomainChecked))
+
+	report, err = checker.CheckWithReport(context.Background(), "foo@unknown.example")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(InvalidDomain))
+
+	// without the option, the MX hosts are not checked
+	checker = NewChecker(WithChecks(SyntaxCheck|DisposableCheck), WithResolver(resolver))
+	report, err = checker.CheckWithReport(context.Background(), "foo@fresh-throwaway.example")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(SyntaxChecked))
+	assert.Equal(t, SyntaxLevel, report.Level)
 }
@@ -0,0 +1,14 @@
+package mailck
+
+import (
+	"strings"
+)
+
+//go:generate go run ./genfreeprovider -o free_provider_list.go free_provider_domains.txt
+
+// CheckFreeProvider returns true if the mail belongs to a free mail provider like gmail.com, false otherwise.
+// Such addresses are usually private, in contrast to the addresses of company domains.
+func CheckFreeProvider(checkEmail string) bool {
+	host := strings.ToLower(hostname(asciiAddress(checkEmail)))
+	return FreeProviderDomains[host]
+}
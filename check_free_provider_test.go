@@ -0,0 +1,41 @@
+package mailck
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCheckFreeProvider(t *testing.T) {
+	assert.True(t, CheckFreeProvider("foo@gmail.com"))
+	assert.True(t, CheckFreeProvider("foo@GMX.de"))
+	assert.True(t, CheckFreeProvider("foo@web.de"))
+	assert.False(t, CheckFreeProvider("sebastian@mancke.net"))
+	assert.False(t, CheckFreeProvider("foo@mail.gmail.com"))
+	assert.False(t, CheckFreeProvider("xxx"))
+}
+
+func TestChecker_FreeProvider(t *testing.T) {
+	report, err := NewChecker(WithChecks(SyntaxCheck)).CheckWithReport(context.Background(), "foo@gmail.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(SyntaxChecked))
+	assert.True(t, report.FreeProvider)
+
+	report, err = NewChecker(WithChecks(SyntaxCheck), WithFreeProviderRejection(true)).CheckWithReport(context.Background(), "foo@gmail.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(FreeMail))
+	assert.True(t, report.IsInvalid())
+
+	checker := NewChecker(WithChecks(SyntaxCheck))
+	report, err = checker.RejectingFreeProviders(true).CheckWithReport(context.Background(), "foo@gmail.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(FreeMail))
+	report, err = checker.CheckWithReport(context.Background(), "foo@gmail.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(SyntaxChecked), "the checker is unchanged")
+
+	report, err = NewChecker(WithChecks(SyntaxCheck), WithFreeProviderRejection(true)).CheckWithReport(context.Background(), "foo@example.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(SyntaxChecked))
+	assert.Equal(t, SyntaxLevel, report.Level)
+}
@@ -0,0 +1,86 @@
+package mailck
+
+import (
+	"strings"
+)
+
+// RoleAccounts contains the local parts of role based addresses like info@ or postmaster@,
+// which belong to a function or a team instead of a person.
+// The entries are normalized by normalizeRole. The map is read concurrently by the checks,
+// so it must not be modified. Use WithRoleAccounts to add local parts for a Checker.
+var RoleAccounts = map[string]bool{}
+
+// CheckRole returns true, if the mail is a role based address like info@example.com, false otherwise.
+// Subaddresses like info+shop@example.com and separators like in no-reply@example.com are ignored.
+func CheckRole(checkEmail string) bool {
+	localPart, ok := roleLocalPart(checkEmail)
+	return ok && RoleAccounts[localPart]
+}
+
+// isRole returns true, if the mail is contained in the RoleAccounts or the role accounts of the checker.
+func (c *Checker) isRole(checkEmail string) bool {
+	localPart, ok := roleLocalPart(checkEmail)
+	return ok && (RoleAccounts[localPart] || c.roleAccounts[localPart])
+}
+
+// roleLocalPart returns the normalized local part of the mail.
+func roleLocalPart(checkEmail string) (string, bool) {
+	at := strings.LastIndex(checkEmail, "@")
+	if at == -1 {
+		return "", false
+	}
+	return normalizeRole(checkEmail[:at]), true
+}
+
+// addRoleAccounts adds the normalized local parts to the set.
+func addRoleAccounts(set map[string]bool, localParts ...string) {
+	for _, localPart := range localParts {
+		set[normalizeRole(localPart)] = true
+	}
+}
+
+// normalizeRole lowercases the local part and removes the subaddress and the separators.
+func normalizeRole(localPart string) string {
+	if plus := strings.Index(localPart, "+"); plus != -1 {
+		localPart = localPart[:plus]
+	}
+	return strings.NewReplacer(".", "", "-", "", "_", "").Replace(strings.ToLower(localPart))
+}
+
+func init() {
+	// english
+	addRoleAccounts(RoleAccounts,
+		"abuse", "accounting", "accounts", "admin", "administrator", "billing", "careers", "compliance",
+		"contact", "customerservice", "do-not-reply", "donotreply", "enquiries", "enquiry", "feedback", "finance",
+		"hello", "help", "helpdesk", "hostmaster", "hr", "info", "information", "inquiries", "invoice", "invoices",
+		"jobs", "legal", "list", "listserv", "mail", "mailer-daemon", "marketing", "media", "newsletter",
+		"news", "noc", "no-reply", "noreply", "office", "orders", "postmaster", "press", "privacy", "recruiting",
+		"reception", "root", "sales", "security", "service", "staff", "subscribe", "support", "sysadmin",
+		"unsubscribe", "webmaster", "welcome",
+	)
+	// german
+	addRoleAccounts(RoleAccounts,
+		"anfrage", "anfragen", "auftrag", "bestellung", "bewerbung", "buchhaltung", "datenschutz", "einkauf",
+		"empfang", "impressum", "karriere", "kontakt", "kundendienst", "kundenservice", "personal",
+		"presse", "rechnung", "rechnungen", "redaktion", "sekretariat", "technik", "verkauf", "vertrieb",
+		"verwaltung", "zentrale",
+	)
+	// french
+	addRoleAccounts(RoleAccounts,
+		"accueil", "bonjour", "commercial", "comptabilite", "direction", "emploi", "facturation", "recrutement",
+		"secretariat", "serviceclient", "ventes",
+	)
+	// spanish
+	addRoleAccounts(RoleAccounts,
+		"administracion", "atencionalcliente", "ayuda", "contacto", "empleo", "facturacion", "informacion",
+		"prensa", "soporte", "ventas",
+	)
+	// italian
+	addRoleAccounts(RoleAccounts,
+		"amministrazione", "assistenza", "contatti", "fatturazione", "ufficio", "vendite",
+	)
+	// dutch
+	addRoleAccounts(RoleAccounts,
+		"boekhouding", "klantenservice", "ondersteuning", "vacatures", "verkoop",
+	)
+}
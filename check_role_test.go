@@ -0,0 +1,71 @@
+package mailck
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCheckRole(t *testing.T) {
+	tests := []struct {
+		mail string
+		role bool
+	}{
+		{"info@example.com", true},
+		{"INFO@example.com", true},
+		{"postmaster@example.com", true},
+		{"no-reply@example.com", true},
+		{"no_reply@example.com", true},
+		{"sales+web@example.com", true},
+		{"kontakt@example.de", true},
+		{"vertrieb@example.de", true},
+		{"comptabilite@example.fr", true},
+		{"ventas@example.es", true},
+		{"s.mancke@tarent.de", false},
+		{"informatics.student@example.com", false},
+		{"it@example.com", false},
+		{"all@example.com", false},
+		{"post@example.de", false},
+		{"team@example.com", false},
+		{"xxx", false},
+	}
+	for _, test := range tests {
+		t.Run(test.mail, func(t *testing.T) {
+			assert.Equal(t, test.role, CheckRole(test.mail))
+		})
+	}
+}
+
+func TestChecker_WithRoleAccounts(t *testing.T) {
+	checker := NewChecker(WithChecks(SyntaxCheck), WithRoleAccounts("Front-Desk"))
+	report, err := checker.CheckWithReport(context.Background(), "front.desk@example.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Role)
+
+	report, err = checker.CheckWithReport(context.Background(), "info@example.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Role)
+
+	assert.False(t, CheckRole("front.desk@example.com"), "the global list is unchanged")
+	report, err = NewChecker(WithChecks(SyntaxCheck)).CheckWithReport(context.Background(), "front.desk@example.com")
+	assert.NoError(t, err)
+	assert.False(t, report.Role)
+}
+
+func TestChecker_Role(t *testing.T) {
+	report, err := NewChecker(WithChecks(SyntaxCheck)).CheckWithReport(context.Background(), "info@example.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(SyntaxChecked))
+	assert.True(t, report.Role)
+
+	report, err = NewChecker(WithChecks(SyntaxCheck), WithRoleRejection(true)).CheckWithReport(context.Background(), "info@example.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(RoleAccount))
+	assert.True(t, report.IsInvalid())
+	assert.True(t, report.Role)
+
+	report, err = NewChecker(WithChecks(SyntaxCheck), WithRoleRejection(true)).CheckWithReport(context.Background(), "foo@example.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(SyntaxChecked))
+	assert.Equal(t, SyntaxLevel, report.Level)
+}
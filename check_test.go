@@ -2,10 +2,13 @@ package mailck
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/siebenmann/smtpd"
 	"github.com/stretchr/testify/assert"
 	"net"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -14,6 +17,12 @@ func assertResultState(t *testing.T, result Result, expected ResultState) {
 	assert.Equal(t, result.IsValid(), expected == ValidState)
 	assert.Equal(t, result.IsInvalid(), expected == InvalidState)
 	assert.Equal(t, result.IsError(), expected == ErrorState)
+	assert.Equal(t, result.IsRisky(), expected == RiskyState)
+}
+
+func testChecker(port int) *Checker {
+	return NewChecker(WithFromEmail("noreply@mancke.net"), WithHeloName("mancke.net"), WithSMTPPort(port),
+		WithResolver(NewStaticResolver().AddHost("localhost", "127.0.0.1")))
 }
 
 func TestCheckSyntax(t *testing.T) {
@@ -28,6 +37,13 @@ func TestCheckSyntax(t *testing.T) {
 		{"s.mancke@tarent@sdc.de", false},
 		{"s.mancke@tarent.de", true},
 		{"s.Mancke+yzz42@tarent.de", true},
+		{"o'brien@example.com", true},
+		{"a!#$&*/=?^`{|}~@example.com", true},
+		{"foo@example.museumsmuseumsmuseumsmuseumsmuseumsmuseumsmuseumsmuseum", true},
+		{"foo..bar@example.com", false},
+		{".foo@example.com", false},
+		{"\"foo bar\"@example.com", false},
+		{"foo@[192.0.2.1]", false},
 	}
 
 	for _, test := range tests {
@@ -51,10 +67,15 @@ func TestCheck(t *testing.T) {
 		{"foo@mailinator.com", Disposable, nil, InvalidState},
 	}
 
+	resolver := NewStaticResolver().
+		AddMX("mancke.net", "mx.mancke.net.").
+		AddMX("mailinator.com", "mail.mailinator.com.")
+	checker := NewChecker(WithFromEmail("noreply@mancke.net"), WithResolver(resolver))
+
 	for _, test := range tests {
 		t.Run(fmt.Sprintf("regular %v", test.mail), func(t *testing.T) {
 			start := time.Now()
-			result, err := Check("noreply@mancke.net", test.mail)
+			result, err := checker.Check(test.mail)
 			assert.Equal(t, test.result, result)
 			assert.Equal(t, test.err, err)
 			assertResultState(t, result, test.expectedState)
@@ -63,7 +84,7 @@ func TestCheck(t *testing.T) {
 		t.Run(fmt.Sprintf("context %v", test.mail), func(t *testing.T) {
 			start := time.Now()
 			ctx, cancel := context.WithTimeout(context.Background(), 150*time.Millisecond)
-			result, err := CheckWithContext(ctx, "noreply@mancke.net", test.mail)
+			result, err := checker.CheckWithContext(ctx, test.mail)
 			assert.Equal(t, test.result, result)
 			assert.Equal(t, test.err, err)
 			assertResultState(t, result, test.expectedState)
@@ -74,6 +95,25 @@ func TestCheck(t *testing.T) {
 	}
 }
 
+func TestCheck_DNSFailure(t *testing.T) {
+	resolver := NewStaticResolver().ServFail("broken.example.com")
+	checker := NewChecker(WithFromEmail("noreply@mancke.net"), WithResolver(resolver), WithDialer(failingDialer{t}))
+
+	// a temporary failure of the nameserver does not make the domain invalid
+	result, err := checker.Check("foo@broken.example.com")
+	assert.Equal(t, DNSFailure, result)
+	assertResultState(t, result, ErrorState)
+	var dnsErr *DNSError
+	if assert.True(t, errors.As(err, &dnsErr)) {
+		assert.Equal(t, "broken.example.com", dnsErr.Domain)
+	}
+	assert.False(t, errors.Is(err, ErrTimeout))
+
+	result, err = checker.Check("foo@unknown.example.com")
+	assert.NoError(t, err)
+	assert.True(t, result.Is(InvalidDomain))
+}
+
 func Test_checkMailbox(t *testing.T) {
 	tests := []struct {
 		stopAt        smtpd.Command
@@ -91,7 +131,7 @@ func Test_checkMailbox(t *testing.T) {
 		t.Run(fmt.Sprintf("stop at: %v", test.stopAt), func(t *testing.T) {
 			dummyServer := NewDummySMTPServer("localhost:2525", test.stopAt, false, 0)
 			defer dummyServer.Close()
-			result, err := checkMailbox(noContext, "noreply@mancke.net", "foo@bar.de", []*net.MX{{Host: "localhost"}}, 2525)
+			result, err := testChecker(2525).checkMailbox(context.Background(), "foo@bar.de", []*net.MX{{Host: "localhost"}}, newRecorder("foo@bar.de"))
 			assert.Equal(t, test.result, result)
 			if test.expectError {
 				assert.Error(t, err)
@@ -106,41 +146,221 @@ func Test_checkMailbox(t *testing.T) {
 func Test_checkMailbox_MailserverCloesAfterConnect(t *testing.T) {
 	dummyServer := NewDummySMTPServer("localhost:2525", smtpd.NOOP, true, 0)
 	defer dummyServer.Close()
-	result, err := checkMailbox(noContext, "noreply@mancke.net", "foo@bar.de", []*net.MX{{Host: "localhost"}}, 2525)
+	result, err := testChecker(2525).checkMailbox(context.Background(), "foo@bar.de", []*net.MX{{Host: "localhost"}}, newRecorder("foo@bar.de"))
 	assert.Equal(t, MailserverError, result)
 	assert.Error(t, err)
 	assertResultState(t, result, ErrorState)
 }
 
 func Test_checkMailbox_NetworkError(t *testing.T) {
-	result, err := checkMailbox(noContext, "noreply@mancke.net", "foo@bar.de", []*net.MX{{Host: "localhost"}}, 6666)
+	result, err := testChecker(6666).checkMailbox(context.Background(), "foo@bar.de", []*net.MX{{Host: "localhost"}}, newRecorder("foo@bar.de"))
 	assert.Equal(t, NetworkError, result)
 	assert.Error(t, err)
 	assertResultState(t, result, ErrorState)
 }
 
+func Test_checkMailbox_AcceptAll(t *testing.T) {
+	tests := []struct {
+		validRcpts []string
+		result     Result
+	}{
+		{nil, AcceptAll},
+		{[]string{"foo@bar.de"}, Valid},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("valid rcpts %v", test.validRcpts), func(t *testing.T) {
+			dummyServer := NewDummySMTPServer("localhost:2525", smtpd.NOOP, false, 0)
+			dummyServer.validRcpts = test.validRcpts
+			defer dummyServer.Close()
+			checker := NewChecker(WithFromEmail("noreply@mancke.net"), WithHeloName("mancke.net"), WithSMTPPort(2525), WithAcceptAllDetection(true))
+			result, err := checker.checkMailbox(context.Background(), "foo@bar.de", []*net.MX{{Host: "localhost"}}, newRecorder("foo@bar.de"))
+			assert.NoError(t, err)
+			assert.Equal(t, test.result, result)
+		})
+	}
+}
+
+func Test_checkMailbox_TemporaryFailure(t *testing.T) {
+	tests := []struct {
+		title    string
+		policy   RetryPolicy
+		timeout  time.Duration
+		expected Result
+	}{
+		{"no retry", RetryPolicy{}, time.Second, TemporaryFailure},
+		{"retry", RetryPolicy{Retries: 2, Delay: 10 * time.Millisecond}, time.Second, Valid},
+		{"retry exceeds deadline", RetryPolicy{Retries: 2, Delay: time.Second}, 200 * time.Millisecond, TemporaryFailure},
+	}
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			dummyServer := NewDummySMTPServer("localhost:2525", smtpd.NOOP, false, 0)
+			dummyServer.tempfailRcpts = 1
+			defer dummyServer.Close()
+			checker := NewChecker(WithFromEmail("noreply@mancke.net"), WithHeloName("mancke.net"), WithSMTPPort(2525), WithRetryPolicy(test.policy))
+			ctx, cancel := context.WithTimeout(context.Background(), test.timeout)
+			defer cancel()
+			start := time.Now()
+			result, err := checker.checkMailbox(ctx, "foo@bar.de", []*net.MX{{Host: "localhost"}}, newRecorder("foo@bar.de"))
+			assert.Equal(t, test.expected, result)
+			assert.WithinDuration(t, time.Now(), start, test.timeout)
+			if test.expected == TemporaryFailure {
+				assertResultState(t, result, ErrorState)
+				tempErr, ok := err.(*TemporaryError)
+				if assert.True(t, ok) {
+					assert.Equal(t, 4, tempErr.Code/100)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_checkMailbox_SenderBlocked(t *testing.T) {
+	tests := []struct {
+		rejectAt  smtpd.Command
+		rejectMsg string
+		result    Result
+	}{
+		{smtpd.HELO, "Client host rejected: listed at zen.spamhaus.org", SenderBlocked},
+		{smtpd.MAILFROM, "5.7.1 Access denied", MailserverError},
+		{smtpd.MAILFROM, "Sender address rejected", MailserverError},
+		{smtpd.RCPTTO, "5.7.1 Service unavailable; Client host blocked using Barracuda Reputation", SenderBlocked},
+		{smtpd.RCPTTO, "5.7.1 Relaying denied", PolicyRejection},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v %v", test.rejectAt, test.rejectMsg), func(t *testing.T) {
+			dummyServer := NewDummySMTPServer("localhost:2525", test.rejectAt, false, 0)
+			dummyServer.rejectMsg = test.rejectMsg
+			defer dummyServer.Close()
+			result, err := testChecker(2525).checkMailbox(context.Background(), "foo@bar.de", []*net.MX{{Host: "localhost"}}, newRecorder("foo@bar.de"))
+			assert.Equal(t, test.result, result)
+			assert.Error(t, err)
+			if test.result == SenderBlocked {
+				blockedErr, ok := err.(*SenderBlockedError)
+				if assert.True(t, ok) {
+					assert.Equal(t, "localhost", blockedErr.Host)
+				}
+			}
+		})
+	}
+}
+
+func Test_checkMailbox_SenderBlockedByTempfail(t *testing.T) {
+	dummyServer := NewDummySMTPServer("localhost:2525", smtpd.NOOP, false, 0)
+	dummyServer.tempfailRcpts = 1
+	dummyServer.tempfailMsg = "4.7.1 Client host rejected: blocked using zen.spamhaus.org"
+	defer dummyServer.Close()
+
+	result, err := testChecker(2525).checkMailbox(context.Background(), "foo@bar.de", []*net.MX{{Host: "localhost"}}, newRecorder("foo@bar.de"))
+	assert.Equal(t, SenderBlocked, result)
+	blockedErr, ok := err.(*SenderBlockedError)
+	if assert.True(t, ok) {
+		assert.Equal(t, "localhost", blockedErr.Host)
+		assert.Equal(t, 450, blockedErr.Reply.Code)
+	}
+}
+
+func Test_checkMailbox_MXFailover(t *testing.T) {
+	dummyServer := NewDummySMTPServer("localhost:2525", smtpd.NOOP, false, 0)
+	defer dummyServer.Close()
+
+	tests := []struct {
+		title  string
+		banner string
+	}{
+		{"connection refused", ""},
+		{"service not available", "421 4.3.2 Service not available\r\n"},
+		{"no greeting", "-"},
+	}
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			if test.banner != "" {
+				ln := listenWithBanner(t, "127.0.0.2:2525", test.banner)
+				defer ln.Close()
+			}
+			checker := NewChecker(WithFromEmail("noreply@mancke.net"), WithHeloName("mancke.net"), WithSMTPPort(2525),
+				WithTimeouts(Timeouts{SMTP: 200 * time.Millisecond}))
+			rec := newRecorder("foo@bar.de")
+			result, err := checker.checkMailbox(context.Background(), "foo@bar.de", []*net.MX{{Host: "127.0.0.2"}, {Host: "localhost"}}, rec)
+			assert.NoError(t, err)
+			assert.Equal(t, Valid, result)
+			failover := rec.report.MXFailover
+			if assert.NotNil(t, failover) {
+				assert.Equal(t, "localhost", failover.Host)
+				assert.Len(t, failover.Skipped, 1)
+				assert.Equal(t, "127.0.0.2", failover.Skipped[0].Host)
+				assert.NotEmpty(t, failover.Skipped[0].Reason)
+			}
+		})
+	}
+}
+
+func Test_checkMailbox_MXFailover_AllFailed(t *testing.T) {
+	rec := newRecorder("foo@bar.de")
+	result, err := testChecker(6666).checkMailbox(context.Background(), "foo@bar.de", []*net.MX{{Host: "127.0.0.2"}, {Host: "localhost"}}, rec)
+	assert.Error(t, err)
+	assert.Equal(t, NetworkError, result)
+	if assert.NotNil(t, rec.report.MXFailover) {
+		assert.Equal(t, "", rec.report.MXFailover.Host)
+		assert.Len(t, rec.report.MXFailover.Skipped, 2)
+	}
+}
+
+func Test_checkMailbox_NoFailoverOnRejection(t *testing.T) {
+	dummyServer := NewDummySMTPServer("localhost:2525", smtpd.RCPTTO, false, 0)
+	defer dummyServer.Close()
+	result, err := testChecker(2525).checkMailbox(context.Background(), "foo@bar.de", []*net.MX{{Host: "localhost"}, {Host: "127.0.0.2"}}, newRecorder("foo@bar.de"))
+	assert.NoError(t, err)
+	assert.Equal(t, MailboxUnavailable, result)
+}
+
+// listenWithBanner accepts connections and sends the banner. The banner "-" sends nothing.
+func listenWithBanner(t *testing.T, listen, banner string) net.Listener {
+	ln, err := net.Listen("tcp", listen)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			if banner != "-" {
+				conn.Write([]byte(banner))
+				conn.Close()
+			}
+		}
+	}()
+	return ln
+}
+
 func Test_checkMailboxContext(t *testing.T) {
 	deltas := []struct {
 		delayTime      time.Duration
 		contextTime    time.Duration
 		expectedResult Result
+		expectedPhase  Phase
 	}{
-		{0, 0, TimeoutError},
-		{0, time.Second, Valid},
-		{time.Millisecond * 1500, 200 * time.Millisecond, TimeoutError},
+		{0, 0, TimeoutError, PhaseConnect},
+		{0, time.Second, Valid, ""},
+		{time.Millisecond * 1500, 200 * time.Millisecond, TimeoutError, PhaseHelo},
 	}
 	for _, d := range deltas {
 		t.Run(fmt.Sprintf("context time %v delay %v expected %v", d.contextTime, d.delayTime, d.expectedResult.Result), func(t *testing.T) {
 			dummyServer := NewDummySMTPServer("localhost:2528", smtpd.QUIT, false, d.delayTime)
 			start := time.Now()
 			ctx, cancel := context.WithTimeout(context.Background(), d.contextTime)
-			result, err := checkMailbox(ctx, "noreply@mancke.net", "foo@bar.de", []*net.MX{{Host: "127.0.0.1"}}, 2528)
+			rec := newRecorder("foo@bar.de")
+			result, err := testChecker(2528).checkMailbox(ctx, "foo@bar.de", []*net.MX{{Host: "127.0.0.1"}}, rec)
 			if d.expectedResult == Valid {
 				assert.NoError(t, err)
 			} else {
 				assert.Error(t, err)
 			}
 			assert.Equal(t, d.expectedResult, result)
+			assert.Equal(t, d.expectedPhase, rec.report.TimeoutPhase)
 			// confirm that we completed within requested time
 			// add 10ms of wiggle room
 			assert.WithinDuration(t, time.Now(), start, d.contextTime+10*time.Millisecond)
@@ -156,6 +376,10 @@ type DummySMTPServer struct {
 	rejectAt          smtpd.Command
 	closeAfterConnect bool
 	delay             time.Duration
+	validRcpts        []string
+	tempfailRcpts     int32
+	tempfailMsg       string
+	rejectMsg         string
 }
 
 func NewDummySMTPServer(listen string, rejectAt smtpd.Command, closeAfterConnect bool, delay time.Duration) *DummySMTPServer {
@@ -204,9 +428,20 @@ func (smtpserver *DummySMTPServer) handleClient(conn net.Conn) {
 	for smtpserver.running {
 		event := c.Next()
 		time.Sleep(smtpserver.delay)
-		if event.Cmd == smtpserver.rejectAt ||
-			(smtpserver.rejectAt == smtpd.HELO && event.Cmd == smtpd.EHLO) {
-			c.Reject()
+		if event.Cmd == smtpd.RCPTTO && atomic.AddInt32(&smtpserver.tempfailRcpts, -1) >= 0 {
+			if smtpserver.tempfailMsg != "" {
+				c.TempfailMsg("%s", smtpserver.tempfailMsg)
+			} else {
+				c.Tempfail()
+			}
+		} else if event.Cmd == smtpserver.rejectAt ||
+			(smtpserver.rejectAt == smtpd.HELO && event.Cmd == smtpd.EHLO) ||
+			(event.Cmd == smtpd.RCPTTO && smtpserver.validRcpts != nil && !contains(smtpserver.validRcpts, strings.Trim(event.Arg, "<>"))) {
+			if smtpserver.rejectMsg != "" {
+				c.RejectMsg("%s", smtpserver.rejectMsg)
+			} else {
+				c.Reject()
+			}
 		} else {
 			c.Accept()
 		}
@@ -215,3 +450,12 @@ func (smtpserver *DummySMTPServer) handleClient(conn net.Conn) {
 		}
 	}
 }
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,439 @@
+package mailck
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Checks is a set of check stages, which can be combined with a bitwise or.
+type Checks uint
+
+const (
+	// SyntaxCheck checks the syntax of the email address.
+	SyntaxCheck Checks = 1 << iota
+	// DisposableCheck checks the domain against the list of disposable mail providers.
+	DisposableCheck
+	// MailboxCheck checks the mailbox by connecting to the target mailserver.
+	MailboxCheck
+
+	// AllChecks enables all check stages.
+	AllChecks = SyntaxCheck | DisposableCheck | MailboxCheck
+)
+
+// Has returns true, if all stages of other are contained in c.
+func (c Checks) Has(other Checks) bool {
+	return c&other == other
+}
+
+// Level is the depth of a check. Each level contains the checks of the levels before.
+type Level string
+
+const (
+	// SyntaxLevel checks the address without network access, e.g. the syntax and the disposable domains.
+	SyntaxLevel Level = "syntax"
+	// DomainLevel looks up the mailservers of the domain in addition.
+	DomainLevel Level = "domain"
+	// MailboxLevel checks the mailbox at the mailserver in addition.
+	MailboxLevel Level = "mailbox"
+)
+
+// ParseLevel returns the level of the name, e.g. "domain" for the DomainLevel.
+func ParseLevel(name string) (Level, error) {
+	if level := Level(name); level.depth() != 0 {
+		return level, nil
+	}
+	return "", fmt.Errorf("unknown check level %q", name)
+}
+
+// depth orders the levels. It is zero for unknown levels.
+func (l Level) depth() int {
+	switch l {
+	case SyntaxLevel:
+		return 1
+	case DomainLevel:
+		return 2
+	case MailboxLevel:
+		return 3
+	}
+	return 0
+}
+
+// Timeouts configures the maximum duration of the single phases of a check.
+// A zero value means, that the phase is only limited by the context.
+// The timeouts of the SMTP commands are enforced by deadlines on the connection.
+type Timeouts struct {
+	// Lookup limits the DNS lookup of the MX records.
+	Lookup time.Duration
+	// Connect limits the TCP connect to a mailserver.
+	Connect time.Duration
+	// Banner limits the wait for the greeting of the mailserver.
+	Banner time.Duration
+	// Helo limits the EHLO or HELO command. It applies to STARTTLS including the handshake as well.
+	Helo time.Duration
+	// MailFrom limits the MAIL FROM command.
+	MailFrom time.Duration
+	// RcptTo limits each RCPT TO command.
+	RcptTo time.Duration
+	// SMTP limits the whole SMTP conversation with the mailserver.
+	SMTP time.Duration
+}
+
+// DefaultTimeouts are used by checkers without WithTimeouts option,
+// so that a check can't hang forever on a slow mailserver.
+var DefaultTimeouts = Timeouts{
+	Lookup:   10 * time.Second,
+	Connect:  10 * time.Second,
+	Banner:   30 * time.Second,
+	Helo:     30 * time.Second,
+	MailFrom: 30 * time.Second,
+	RcptTo:   30 * time.Second,
+}
+
+// Dialer opens the connections to the mailservers, e.g. a *net.Dialer.
+// It is called with the ip addresses of the mailservers, which are looked up by the Resolver.
+type Dialer interface {
+	DialContext(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// Checker performs email checks with a fixed configuration.
+// A Checker is safe for concurrent use.
+type Checker struct {
+	fromEmail string
+	heloName  string
+	resolver  Resolver
+	dialer    Dialer
+	smtpPort  int
+	timeouts  Timeouts
+	checks    Checks
+	level     Level
+	// syntaxProfile is the strictness of the SyntaxCheck
+	syntaxProfile SyntaxProfile
+	acceptAll     bool
+	rejectRoles   bool
+	roleAccounts  map[string]bool
+	rejectFree    bool
+	retry         RetryPolicy
+	tlsMode       TLSMode
+	tlsConfig     *tls.Config
+	disposable    *DisposableList
+	disposableMX  bool
+	allowlist     *AddressList
+	denylist      *AddressList
+	pipeline      *Pipeline
+
+	// helo is the name for the HELO command, derived at construction
+	helo string
+	// err is a configuration error found at construction
+	err error
+}
+
+// Option configures a Checker.
+type Option func(*Checker)
+
+// NewChecker creates a Checker with the supplied options.
+// Without options, all checks are enabled and the system resolver, a plain net.Dialer, port 25
+// and the DefaultTimeouts are used.
+func NewChecker(options ...Option) *Checker {
+	c := &Checker{
+		resolver: net.DefaultResolver,
+		dialer:   &net.Dialer{},
+		smtpPort: 25,
+		timeouts: DefaultTimeouts,
+		checks:   AllChecks,
+		level:    MailboxLevel,
+	}
+	c.pipeline = NewPipeline()
+	for _, o := range options {
+		o(c)
+	}
+	c.err = c.validate()
+	return c
+}
+
+// validate derives the HELO name and returns the first configuration error.
+func (c *Checker) validate() error {
+	if err := c.initHelo(); err != nil {
+		return err
+	}
+	if err := c.pipeline.Err(); err != nil {
+		return err
+	}
+	_, err := ParseLevel(string(c.level))
+	return err
+}
+
+// Err returns the configuration error found at construction of the checker, e.g. an invalid HELO name.
+// If set, all checks fail with ServiceError.
+func (c *Checker) Err() error {
+	return c.err
+}
+
+// WithFromEmail sets the from address used in the communication to the foreign mailserver.
+func WithFromEmail(fromEmail string) Option {
+	return func(c *Checker) {
+		c.fromEmail = fromEmail
+	}
+}
+
+// WithHeloName sets the name sent with the HELO command.
+// It has to be a hostname or an address literal like [192.0.2.1].
+// If not set, the domain of the from address or the fully qualified name of the machine is used,
+// which falls back to "localhost", if it can't be looked up.
+func WithHeloName(heloName string) Option {
+	return func(c *Checker) {
+		c.heloName = heloName
+	}
+}
+
+// WithResolver sets the resolver for the DNS lookups, including the addresses of the mailservers.
+func WithResolver(resolver Resolver) Option {
+	return func(c *Checker) {
+		c.resolver = resolver
+	}
+}
+
+// WithDialer sets the dialer for the connections to the mailservers.
+func WithDialer(dialer Dialer) Option {
+	return func(c *Checker) {
+		c.dialer = dialer
+	}
+}
+
+// WithSMTPPort sets the port of the target mailservers.
+func WithSMTPPort(port int) Option {
+	return func(c *Checker) {
+		c.smtpPort = port
+	}
+}
+
+// WithTimeouts sets the timeouts for the single phases of a check.
+// It replaces the DefaultTimeouts completely, so unset phases are only limited by the context.
+func WithTimeouts(timeouts Timeouts) Option {
+	return func(c *Checker) {
+		c.timeouts = timeouts
+	}
+}
+
+// WithChecks sets the check stages, which are performed by Check and CheckWithContext.
+func WithChecks(checks Checks) Option {
+	return func(c *Checker) {
+		c.checks = checks
+	}
+}
+
+// WithLevel sets the depth of the checks performed by Check and CheckWithContext.
+// The default is the MailboxLevel. The level limits the enabled checks, but does not enable further ones.
+func WithLevel(level Level) Option {
+	return func(c *Checker) {
+		c.level = level
+	}
+}
+
+// WithSyntaxProfile sets the strictness of the SyntaxCheck. The default is the PracticalProfile.
+func WithSyntaxProfile(profile SyntaxProfile) Option {
+	return func(c *Checker) {
+		c.syntaxProfile = profile
+	}
+}
+
+// WithAcceptAllDetection enables the detection of catch-all domains.
+// After the checked address was accepted, a surely nonexistent address of the same domain is probed.
+// If the mailserver accepts it as well, the result is AcceptAll instead of Valid.
+func WithAcceptAllDetection(enabled bool) Option {
+	return func(c *Checker) {
+		c.acceptAll = enabled
+	}
+}
+
+// WithRoleRejection makes role based addresses like info@example.com invalid with the RoleAccount result.
+// Otherwise, they are only flagged by the Role field of the CheckReport.
+func WithRoleRejection(enabled bool) Option {
+	return func(c *Checker) {
+		c.rejectRoles = enabled
+	}
+}
+
+// WithRoleAccounts adds local parts of role based addresses to the RoleAccounts for the checker,
+// e.g. "frontdesk". They are normalized like the RoleAccounts.
+func WithRoleAccounts(localParts ...string) Option {
+	return func(c *Checker) {
+		if c.roleAccounts == nil {
+			c.roleAccounts = map[string]bool{}
+		}
+		addRoleAccounts(c.roleAccounts, localParts...)
+	}
+}
+
+// WithFreeProviderRejection makes addresses of free mail providers like gmail.com invalid with the FreeMail result.
+// Otherwise, they are only flagged by the FreeProvider field of the CheckReport.
+func WithFreeProviderRejection(enabled bool) Option {
+	return func(c *Checker) {
+		c.rejectFree = enabled
+	}
+}
+
+// WithDisposableList sets the list of disposable domains used by the DisposableCheck,
+// e.g. a list loaded by LoadDisposableList, which is reloaded at runtime.
+// Without this option, the embedded DisposableDomains are used.
+func WithDisposableList(list *DisposableList) Option {
+	return func(c *Checker) {
+		c.disposable = list
+	}
+}
+
+// WithDisposableMXCheck enables the detection of disposable domains by their mailservers.
+// If an MX host of the domain belongs to the DisposableMXDomains, e.g. mail.mailinator.com,
+// the result is Disposable, even if the domain itself is not listed.
+// The check needs the MX lookup, so it is done with the DisposableCheck, even without MailboxCheck.
+func WithDisposableMXCheck(enabled bool) Option {
+	return func(c *Checker) {
+		c.disposableMX = enabled
+	}
+}
+
+// WithAllowlist sets a list of addresses, which are Valid without further checks, e.g. the domains of partners.
+// The list is evaluated after the SyntaxCheck and the denylist, but before all other checks.
+func WithAllowlist(list *AddressList) Option {
+	return func(c *Checker) {
+		c.allowlist = list
+	}
+}
+
+// WithDenylist sets a list of addresses, which are invalid with the Denylisted result without further checks.
+// The list is evaluated after the SyntaxCheck, before the allowlist and all other checks.
+func WithDenylist(list *AddressList) Option {
+	return func(c *Checker) {
+		c.denylist = list
+	}
+}
+
+// WithPipeline changes the pipeline of stages performed by Check, e.g. to add custom stages,
+// or to reorder or remove built-in ones. The configure function gets the pipeline of the checker,
+// which is the default pipeline, if not changed by another WithPipeline option.
+// Errors of the modifications of the pipeline are returned by Err.
+func WithPipeline(configure func(p *Pipeline)) Option {
+	return func(c *Checker) {
+		configure(c.pipeline)
+	}
+}
+
+var (
+	defaultCheckerOnce sync.Once
+	defaultCheckerInst *Checker
+)
+
+// defaultChecker returns the checker used by the package level check functions.
+// It is created at the first use, because its HELO name may need a DNS lookup.
+func defaultChecker() *Checker {
+	defaultCheckerOnce.Do(func() {
+		defaultCheckerInst = NewChecker()
+	})
+	return defaultCheckerInst
+}
+
+// AtLevel returns a copy of the checker, which checks up to the level,
+// e.g. the SyntaxLevel for a cheap check while typing and the MailboxLevel on submit.
+func (c *Checker) AtLevel(level Level) *Checker {
+	cp := *c
+	cp.level = level
+	if _, err := ParseLevel(string(level)); err != nil && cp.err == nil {
+		cp.err = err
+	}
+	return &cp
+}
+
+// RejectingFreeProviders returns a copy of the checker, which rejects the addresses of free mail providers
+// as with WithFreeProviderRejection, e.g. for a single request.
+func (c *Checker) RejectingFreeProviders(reject bool) *Checker {
+	cp := *c
+	cp.rejectFree = reject
+	return &cp
+}
+
+// withFromEmail returns a copy of the checker using the supplied from address.
+func (c *Checker) withFromEmail(fromEmail string) *Checker {
+	cp := *c
+	cp.fromEmail = fromEmail
+	cp.err = cp.validate()
+	return &cp
+}
+
+// initHelo derives and validates the HELO name.
+// Without a HELO name and from address, the fully qualified name of the machine is used.
+func (c *Checker) initHelo() error {
+	c.helo = c.heloName
+	if c.helo == "" && strings.Contains(c.fromEmail, "@") {
+		c.helo = hostname(c.fromEmail)
+	}
+	if c.helo == "" {
+		c.helo = localHeloName()
+	}
+	return validateHeloName(c.helo)
+}
+
+// fqdnLookupTimeout limits the lookup of the name of the machine.
+const fqdnLookupTimeout = 2 * time.Second
+
+var (
+	localHeloOnce sync.Once
+	localHelo     string
+)
+
+// localHeloName returns the fully qualified name of the machine, which is looked up once.
+func localHeloName() string {
+	localHeloOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), fqdnLookupTimeout)
+		defer cancel()
+		localHelo = fqdn(ctx, os.Hostname, net.DefaultResolver.LookupCNAME)
+	})
+	return localHelo
+}
+
+// fqdn returns the canonical name of the hostname. If the name can't be determined,
+// "localhost" is returned, so that the checks still work.
+func fqdn(ctx context.Context, hostname func() (string, error), lookupCNAME func(ctx context.Context, host string) (string, error)) string {
+	name, err := hostname()
+	if err != nil {
+		return "localhost"
+	}
+	cname, err := lookupCNAME(ctx, name)
+	if err != nil {
+		return "localhost"
+	}
+	cname = strings.TrimSuffix(cname, ".")
+	if validateHeloName(cname) != nil {
+		return "localhost"
+	}
+	return cname
+}
+
+var hostnameRexp = regexp.MustCompile(`^([A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\.)*[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\.?$`)
+
+// validateHeloName checks, that name is a hostname or an address literal (RFC 5321, section 4.1.3).
+func validateHeloName(name string) error {
+	if strings.HasPrefix(name, "[") {
+		if literalIP(name) == nil {
+			return fmt.Errorf("invalid address literal as HELO name: %q", name)
+		}
+		return nil
+	}
+	if len(name) > 253 || !hostnameRexp.MatchString(name) {
+		return fmt.Errorf("invalid HELO name: %q", name)
+	}
+	return nil
+}
+
+// withTimeout derives a context limited by timeout, if the timeout is set.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,321 @@
+package mailck
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"github.com/siebenmann/smtpd"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"io/ioutil"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewChecker_Defaults(t *testing.T) {
+	c := NewChecker()
+	assert.Equal(t, 25, c.smtpPort)
+	assert.Equal(t, AllChecks, c.checks)
+	assert.Equal(t, net.DefaultResolver, c.resolver)
+	assert.NotNil(t, c.dialer)
+	assert.Equal(t, DefaultTimeouts, c.timeouts)
+}
+
+func TestNewChecker_Options(t *testing.T) {
+	dialer := &net.Dialer{KeepAlive: time.Second}
+	timeouts := Timeouts{Lookup: time.Second, Connect: 2 * time.Second, SMTP: 3 * time.Second}
+	c := NewChecker(
+		WithFromEmail("noreply@example.com"),
+		WithHeloName("mx.example.com"),
+		WithDialer(dialer),
+		WithSMTPPort(2525),
+		WithTimeouts(timeouts),
+		WithChecks(SyntaxCheck|DisposableCheck),
+	)
+	assert.Equal(t, "noreply@example.com", c.fromEmail)
+	assert.Equal(t, "mx.example.com", c.helo)
+	assert.Equal(t, dialer, c.dialer)
+	assert.Equal(t, 2525, c.smtpPort)
+	assert.Equal(t, timeouts, c.timeouts)
+	assert.True(t, c.checks.Has(SyntaxCheck))
+	assert.False(t, c.checks.Has(MailboxCheck))
+}
+
+func TestChecker_Checks(t *testing.T) {
+	c := NewChecker(WithChecks(SyntaxCheck | DisposableCheck))
+
+	report, err := c.CheckWithReport(context.Background(), "xxx")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(InvalidSyntax))
+	assert.Equal(t, ReasonMissingAt, report.SyntaxReason)
+
+	report, err = c.CheckWithReport(context.Background(), "foo@mailinator.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(Disposable))
+	assert.Equal(t, &DisposableMatch{Rule: DisposableDomainRule, Domain: "mailinator.com"}, report.DisposableMatch)
+
+	report, err = c.CheckWithReport(context.Background(), "foo@example.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(SyntaxChecked))
+	assert.Equal(t, SyntaxLevel, report.Level)
+
+	report, err = NewChecker(WithChecks(SyntaxCheck)).CheckWithReport(context.Background(), "foo@mailinator.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(SyntaxChecked))
+	assert.Equal(t, SyntaxLevel, report.Level)
+}
+
+func TestChecker_Levels(t *testing.T) {
+	r := NewStaticResolver().AddMX("example.com", "mx.example.com.")
+
+	// no lookup: the unknown domain is not detected
+	report, err := NewChecker(WithResolver(r), WithLevel(SyntaxLevel)).CheckWithReport(context.Background(), "foo@unknown.example")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(SyntaxChecked))
+	assert.Equal(t, SyntaxLevel, report.Level)
+
+	// lookup, but no connection to the mailserver
+	c := NewChecker(WithResolver(r), WithDialer(failingDialer{t}), WithLevel(DomainLevel))
+	report, err = c.CheckWithReport(context.Background(), "foo@example.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(DomainChecked))
+	assert.Equal(t, DomainLevel, report.Level)
+
+	report, err = c.CheckWithReport(context.Background(), "foo@unknown.example")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(InvalidDomain))
+	assert.Equal(t, DomainLevel, report.Level)
+
+	// the level does not enable disabled checks
+	report, err = NewChecker(WithResolver(r), WithChecks(SyntaxCheck)).AtLevel(DomainLevel).CheckWithReport(context.Background(), "foo@unknown.example")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(SyntaxChecked))
+	assert.Equal(t, SyntaxLevel, report.Level)
+
+	report, err = c.AtLevel(SyntaxLevel).CheckWithReport(context.Background(), "foo@unknown.example")
+	assert.NoError(t, err)
+	assert.Equal(t, SyntaxLevel, report.Level)
+	assert.Equal(t, DomainLevel, c.level, "the checker is unchanged")
+}
+
+func TestChecker_InvalidLevel(t *testing.T) {
+	c := NewChecker(WithLevel("full"))
+	assert.EqualError(t, c.Err(), `unknown check level "full"`)
+	assert.EqualError(t, NewChecker().AtLevel("full").Err(), `unknown check level "full"`)
+
+	_, err := c.Check("foo@example.com")
+	assert.Error(t, err)
+}
+
+func TestParseLevel(t *testing.T) {
+	for _, level := range []Level{SyntaxLevel, DomainLevel, MailboxLevel} {
+		parsed, err := ParseLevel(string(level))
+		assert.NoError(t, err)
+		assert.Equal(t, level, parsed)
+	}
+	_, err := ParseLevel("")
+	assert.Error(t, err)
+}
+
+func TestChecker_SMTPTimeout(t *testing.T) {
+	dummyServer := NewDummySMTPServer("localhost:2529", smtpd.QUIT, false, 500*time.Millisecond)
+	defer dummyServer.Close()
+
+	c := NewChecker(WithFromEmail("noreply@mancke.net"), WithHeloName("mancke.net"), WithSMTPPort(2529),
+		WithTimeouts(Timeouts{SMTP: 100 * time.Millisecond}))
+	start := time.Now()
+	rec := newRecorder("foo@bar.de")
+	result, err := c.checkMailbox(context.Background(), "foo@bar.de", []*net.MX{{Host: "localhost"}}, rec)
+	assert.True(t, result.Is(TimeoutError))
+	assert.Equal(t, PhaseHelo, rec.report.TimeoutPhase)
+	assert.Error(t, err)
+	assert.WithinDuration(t, time.Now(), start, 200*time.Millisecond)
+}
+
+func TestChecker_PhaseTimeouts(t *testing.T) {
+	tests := []struct {
+		stallAt  string
+		timeouts Timeouts
+		phase    Phase
+	}{
+		{"", Timeouts{Banner: 100 * time.Millisecond}, PhaseGreeting},
+		{"EHLO", Timeouts{Helo: 100 * time.Millisecond}, PhaseHelo},
+		{"MAIL", Timeouts{MailFrom: 100 * time.Millisecond}, PhaseMailFrom},
+		{"RCPT", Timeouts{RcptTo: 100 * time.Millisecond}, PhaseRcptTo},
+	}
+	for _, test := range tests {
+		t.Run(string(test.phase), func(t *testing.T) {
+			port := startTarpitServer(t, test.stallAt)
+			c := NewChecker(WithFromEmail("noreply@mancke.net"), WithSMTPPort(port), WithTimeouts(test.timeouts))
+
+			start := time.Now()
+			rec := newRecorder("foo@bar.de")
+			result, err := c.checkMailbox(context.Background(), "foo@bar.de", []*net.MX{{Host: "127.0.0.1"}}, rec)
+			assert.WithinDuration(t, time.Now(), start, 500*time.Millisecond)
+			assert.True(t, result.Is(TimeoutError), "unexpected result %v", result.ResultDetail)
+			assert.Equal(t, test.phase, rec.report.TimeoutPhase)
+			assert.True(t, errors.Is(err, ErrTimeout))
+			var smtpErr *SMTPError
+			if assert.True(t, errors.As(err, &smtpErr)) {
+				assert.Equal(t, test.phase, smtpErr.Stage)
+			}
+		})
+	}
+}
+
+func Test_phaseDeadline(t *testing.T) {
+	assert.True(t, phaseDeadline(context.Background(), 0).IsZero())
+	assert.WithinDuration(t, time.Now().Add(time.Second), phaseDeadline(context.Background(), time.Second), 50*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	ctxDeadline, _ := ctx.Deadline()
+	assert.Equal(t, ctxDeadline, phaseDeadline(ctx, 0))
+	assert.Equal(t, ctxDeadline, phaseDeadline(ctx, time.Second))
+	assert.True(t, phaseDeadline(ctx, time.Millisecond).Before(ctxDeadline))
+}
+
+// startTarpitServer starts an SMTP server, which stops answering at the supplied command.
+// With an empty command, it does not even send the greeting.
+func startTarpitServer(t *testing.T, stallAt string) int {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				conn.SetDeadline(time.Now().Add(5 * time.Second))
+				if stallAt == "" {
+					io.Copy(ioutil.Discard, conn)
+					return
+				}
+				conn.Write([]byte("220 localhost ESMTP tarpit\r\n"))
+				r := bufio.NewReader(conn)
+				for {
+					line, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if strings.HasPrefix(strings.ToUpper(line), stallAt) {
+						io.Copy(ioutil.Discard, r)
+						return
+					}
+					conn.Write([]byte("250 Ok\r\n"))
+				}
+			}()
+		}
+	}()
+	return ln.Addr().(*net.TCPAddr).Port
+}
+
+func TestChecker_withFromEmail(t *testing.T) {
+	c := NewChecker(WithFromEmail("a@example.com"))
+	cp := c.withFromEmail("b@example.org")
+	assert.Equal(t, "a@example.com", c.fromEmail)
+	assert.Equal(t, "example.com", c.helo)
+	assert.Equal(t, "b@example.org", cp.fromEmail)
+	assert.Equal(t, "example.org", cp.helo)
+
+	cp = NewChecker(WithHeloName("mx.example.com")).withFromEmail("b@example.org")
+	assert.Equal(t, "mx.example.com", cp.helo)
+
+	// the HELO error of the old from address is dropped
+	badHelo := NewChecker(WithFromEmail("a@bad_host"))
+	assert.Error(t, badHelo.Err())
+	cp = badHelo.withFromEmail("b@example.org")
+	assert.NoError(t, cp.Err())
+	assert.Equal(t, "example.org", cp.helo)
+
+	// other configuration errors are kept
+	invalid := NewChecker(WithPipeline(func(p *Pipeline) { p.Remove("unknown") }))
+	assert.Error(t, invalid.Err())
+	assert.Equal(t, invalid.Err(), invalid.withFromEmail("b@example.org").Err())
+}
+
+func TestChecker_HeloDefaults(t *testing.T) {
+	c := NewChecker()
+	assert.NoError(t, c.Err())
+	assert.Equal(t, localHeloName(), c.helo)
+	assert.Equal(t, "mancke.net", NewChecker(WithFromEmail("noreply@mancke.net")).helo)
+}
+
+func Test_fqdn(t *testing.T) {
+	hostname := func() (string, error) { return "vm", nil }
+	noHostname := func() (string, error) { return "", errors.New("no hostname") }
+	lookup := func(cname string, err error) func(ctx context.Context, host string) (string, error) {
+		return func(ctx context.Context, host string) (string, error) {
+			assert.Equal(t, "vm", host)
+			return cname, err
+		}
+	}
+	tests := []struct {
+		title       string
+		hostname    func() (string, error)
+		lookupCNAME func(ctx context.Context, host string) (string, error)
+		fqdn        string
+	}{
+		{"canonical name", hostname, lookup("vm.example.com.", nil), "vm.example.com"},
+		{"lookup fails", hostname, lookup("", errors.New("no such host")), "localhost"},
+		{"invalid name", hostname, lookup("not a hostname.", nil), "localhost"},
+		{"no hostname", noHostname, lookup("vm.example.com.", nil), "localhost"},
+	}
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			assert.Equal(t, test.fqdn, fqdn(context.Background(), test.hostname, test.lookupCNAME))
+		})
+	}
+}
+
+func TestChecker_InvalidHeloName(t *testing.T) {
+	c := NewChecker(WithFromEmail("noreply@mancke.net"), WithHeloName("not a hostname"))
+	assert.Error(t, c.Err())
+
+	result, err := c.Check("foo@example.com")
+	assert.Equal(t, ServiceError, result)
+	assert.Equal(t, c.Err(), err)
+
+	result, err = c.CheckMailbox("foo@example.com")
+	assert.Equal(t, ServiceError, result)
+	assert.Equal(t, c.Err(), err)
+
+	assert.NoError(t, NewChecker(WithHeloName("mx.example.com")).Err())
+}
+
+func Test_validateHeloName(t *testing.T) {
+	tests := []struct {
+		name  string
+		valid bool
+	}{
+		{"mx.example.com", true},
+		{"mx.example.com.", true},
+		{"localhost", true},
+		{"mail-1.example.com", true},
+		{"[192.0.2.1]", true},
+		{"[IPv6:2001:db8::1]", true},
+		{"", false},
+		{"-mx.example.com", false},
+		{"mx..example.com", false},
+		{"mx_1.example.com", false},
+		{"mx example.com", false},
+		{"[2001:db8::1]", false},
+		{"[IPv6:192.0.2.1]", false},
+		{"[mx.example.com]", false},
+		{strings.Repeat("a", 64) + ".com", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateHeloName(test.name)
+			assert.Equal(t, test.valid, err == nil, "%v", err)
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package mailck
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DisposableList is a set of disposable mail domains, which can be updated at runtime.
+// It contains the embedded DisposableDomains and the domains read from files.
+// A DisposableList is safe for concurrent use. A reload replaces the domains at once,
+// so that concurrent checks see either the old or the new list.
+type DisposableList struct {
+	paths []string
+
+	mutex       sync.RWMutex
+	domains     map[string]bool
+	fingerprint string
+}
+
+// NewDisposableList returns a list with the embedded DisposableDomains and the supplied domains.
+func NewDisposableList(domains ...string) *DisposableList {
+	l := &DisposableList{}
+	l.domains = l.merge(domains)
+	return l
+}
+
+// LoadDisposableList returns a list with the embedded DisposableDomains and the domains of the files.
+// A path may be a file or a directory, of which all files are read.
+// The files contain one domain per line. Empty lines and lines starting with # are ignored.
+func LoadDisposableList(paths ...string) (*DisposableList, error) {
+	l := &DisposableList{paths: paths}
+	if err := l.Reload(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Reload reads the files of the list again.
+// If a file can't be read, the error is returned and the list stays unchanged.
+func (l *DisposableList) Reload() error {
+	fingerprint, err := fingerprintFiles(l.paths)
+	if err != nil {
+		return err
+	}
+	var domains []string
+	for _, file := range filesOf(l.paths) {
+		fileDomains, err := readListFile(file)
+		if err != nil {
+			return err
+		}
+		domains = append(domains, fileDomains...)
+	}
+	merged := l.merge(domains)
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.domains = merged
+	l.fingerprint = fingerprint
+	return nil
+}
+
+// Modified returns true, if the files of the list changed since the last load,
+// e.g. a file was modified, removed or added to a directory.
+func (l *DisposableList) Modified() bool {
+	fingerprint, err := fingerprintFiles(l.paths)
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	return err != nil || fingerprint != l.fingerprint
+}
+
+// Contains returns true, if the domain or one of its parent domains is a disposable mail domain.
+func (l *DisposableList) Contains(domain string) bool {
+	_, ok := l.Match(domain)
+	return ok
+}
+
+// Match returns the matching rule, if the domain or one of its parent domains is a disposable mail domain.
+func (l *DisposableList) Match(domain string) (DisposableMatch, bool) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	return matchDomain(l.domains, domain)
+}
+
+// Len returns the number of domains in the list.
+func (l *DisposableList) Len() int {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	return len(l.domains)
+}
+
+// CheckDisposable returns true if the mail is a disposal mail according to the list, false otherwise.
+func (l *DisposableList) CheckDisposable(checkEmail string) bool {
+	return l.Contains(hostname(asciiAddress(checkEmail)))
+}
+
+// MatchDisposable returns the matching rule, if the mail is a disposal mail according to the list.
+func (l *DisposableList) MatchDisposable(checkEmail string) (DisposableMatch, bool) {
+	return l.Match(hostname(asciiAddress(checkEmail)))
+}
+
+// merge returns a new map with the embedded domains and the supplied ones.
+func (l *DisposableList) merge(domains []string) map[string]bool {
+	merged := make(map[string]bool, len(DisposableDomains)+len(domains))
+	for domain := range DisposableDomains {
+		merged[domain] = true
+	}
+	for _, domain := range domains {
+		if ascii, err := toASCIIDomain(strings.TrimSuffix(strings.ToLower(domain), ".")); err == nil {
+			merged[ascii] = true
+		}
+	}
+	return merged
+}
+
+// filesOf returns the paths, where directories are replaced by the regular files in them.
+func filesOf(paths []string) []string {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil || !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		entries, _ := os.ReadDir(path)
+		for _, entry := range entries {
+			if entry.Type().IsRegular() && !strings.HasPrefix(entry.Name(), ".") {
+				files = append(files, filepath.Join(path, entry.Name()))
+			}
+		}
+	}
+	return files
+}
+
+// fingerprintFiles returns a string, which changes, if one of the files changes.
+func fingerprintFiles(paths []string) (string, error) {
+	var parts []string
+	for _, file := range filesOf(paths) {
+		info, err := os.Stat(file)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, fmt.Sprintf("%v:%v:%v", file, info.Size(), info.ModTime().UnixNano()))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, "\n"), nil
+}
+
+// readListFile returns the lines of the file, without empty lines and comments starting with #.
+func readListFile(file string) ([]string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var domains []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		domains = append(domains, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("can't read %v: %v", file, err)
+	}
+	return domains, nil
+}
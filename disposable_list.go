@@ -1,1567 +1,1590 @@
+// Code generated by gendisposable; DO NOT EDIT.
+
 package mailck
 
 // DisposableDomains is a list of fake mail providers.
-// The list was taken from https://github.com/andreis/disposable
-// License: MIT 
-// Last updated: Sa 4. Mär 21:33:31 CET 2017
+// It is merged from the following sources:
+//
+//   - andreis.txt: https://github.com/andreis/disposable
+//     License: MIT
+//     Copyright (c) andreis and contributors of andreis/disposable
+//     Retrieved: 2017-03-04
+//
+//   - mailck.txt: https://github.com/smancke/mailck
+//     License: MIT
+//     Copyright (c) the contributors of smancke/mailck
 var DisposableDomains = map[string]bool{
-	"0-mail.com": true,
-	"027168.com": true,
-	"0815.ru": true,
-	"0815.su": true,
-	"0clickemail.com": true,
-	"0wnd.net": true,
-	"0wnd.org": true,
-	"10mail.org": true,
-	"10minutemail.cf": true,
-	"10minutemail.co.za": true,
-	"10minutemail.com": true,
-	"10minutemail.de": true,
-	"10minutemail.ga": true,
-	"10minutemail.gq": true,
-	"10minutemail.ml": true,
-	"10minutemail.net": true,
-	"10minutemail.us": true,
-	"10minutenemail.de": true,
-	"123-m.com": true,
-	"12minutemail.com": true,
-	"1ce.us": true,
-	"1chuan.com": true,
-	"1clck2.com": true,
-	"1mail.ml": true,
-	"1pad.de": true,
-	"1up.orangotango.gq": true,
-	"1zhuan.com": true,
-	"2-ch.space": true,
-	"20email.eu": true,
-	"20mail.in": true,
-	"20mail.it": true,
-	"20minute.email": true,
-	"20minutemail.com": true,
-	"21cn.com": true,
-	"225522.ml": true,
-	"24hourmail.com": true,
-	"2ch.coms.hk": true,
-	"2prong.com": true,
-	"30minutemail.com": true,
-	"30wave.com": true,
-	"33mail.com": true,
-	"3d-painting.com": true,
-	"3mail.ga": true,
-	"44556677.igg.biz": true,
-	"466453.usa.cc": true,
-	"4mail.cf": true,
-	"4mail.ga": true,
-	"4warding.com": true,
-	"4warding.net": true,
-	"4warding.org": true,
-	"5mail.cf": true,
-	"5mail.ga": true,
-	"60minutemail.com": true,
-	"675hosting.com": true,
-	"675hosting.net": true,
-	"675hosting.org": true,
-	"69-ew.tk": true,
-	"6ip.us": true,
-	"6mail.cf": true,
-	"6mail.ga": true,
-	"6mail.ml": true,
-	"6paq.com": true,
-	"6url.com": true,
-	"75hosting.com": true,
-	"75hosting.net": true,
-	"75hosting.org": true,
-	"7days-printing.com": true,
-	"7ddf32e.info": true,
-	"7mail.ga": true,
-	"7mail.ml": true,
-	"7tags.com": true,
-	"7uy35p.tk": true,
-	"8mail.cf": true,
-	"8mail.ga": true,
-	"8mail.ml": true,
-	"99experts.com": true,
-	"9mail.cf": true,
-	"9me.site": true,
-	"9ox.net": true,
-	"a-bc.net": true,
-	"a.betr.co": true,
-	"a.wxnw.net": true,
-	"a0.igg.biz": true,
-	"a1.usa.cc": true,
-	"a2.flu.cc": true,
-	"a45.in": true,
-	"abusemail.de": true,
-	"abyssmail.com": true,
-	"ac20mail.in": true,
-	"acentri.com": true,
-	"adbet.co": true,
-	"add3000.pp.ua": true,
-	"adrianou.gq": true,
-	"advantimo.com": true,
-	"afrobacon.com": true,
-	"ag.us.to": true,
-	"agedmail.com": true,
-	"ahk.jp": true,
-	"ajaxapp.net": true,
-	"alivance.com": true,
-	"amail.com": true,
-	"amilegit.com": true,
-	"amiri.net": true,
-	"amiriindustries.com": true,
-	"anappthat.com": true,
-	"ano-mail.net": true,
-	"anon.leemail.me": true,
-	"anonbox.net": true,
-	"anonymail.dk": true,
-	"anonymbox.com": true,
-	"anonymize.com": true,
-	"anotherdomaincyka.tk": true,
-	"antichef.com": true,
-	"antichef.net": true,
-	"antispam.de": true,
-	"antonelli.usa.cc": true,
-	"apkmd.com": true,
-	"appixie.com": true,
-	"armyspy.com": true,
-	"art-en-ligne.pro": true,
-	"arur01.tk": true,
-	"arurgitu.gq": true,
-	"arurimport.ml": true,
-	"asdasd.nl": true,
-	"asiarap.usa.cc": true,
-	"ass.pp.ua": true,
-	"aver.com": true,
-	"avia-tonic.fr": true,
-	"ay33rs.flu.cc": true,
-	"azazazatashkent.tk": true,
-	"azmeil.tk": true,
-	"b0.nut.cc": true,
-	"babau.cf": true,
-	"babau.flu.cc": true,
-	"babau.ga": true,
-	"babau.gq": true,
-	"babau.igg.biz": true,
-	"babau.ml": true,
-	"babau.nut.cc": true,
-	"babau.usa.cc": true,
-	"bareed.ws": true,
-	"barryogorman.com": true,
-	"baxomale.ht.cx": true,
-	"bccto.me": true,
-	"bdmuzic.pw": true,
-	"beddly.com": true,
-	"beefmilk.com": true,
-	"belastingdienst.pw": true,
-	"big1.us": true,
-	"bigprofessor.so": true,
-	"bigstring.com": true,
-	"binka.me": true,
-	"binkmail.com": true,
-	"bio-muesli.net": true,
-	"bione.co": true,
-	"bladesmail.net": true,
-	"blogmyway.org": true,
-	"bloxter.cu.cc": true,
-	"blutig.me": true,
-	"bobmail.info": true,
-	"bodhi.lawlita.com": true,
-	"bofthew.com": true,
-	"bongobongo.cf": true,
-	"bongobongo.flu.cc": true,
-	"bongobongo.ga": true,
-	"bongobongo.igg.biz": true,
-	"bongobongo.ml": true,
-	"bongobongo.nut.cc": true,
-	"bongobongo.tk": true,
-	"bongobongo.usa.cc": true,
-	"bootybay.de": true,
-	"boun.cr": true,
-	"bouncr.com": true,
-	"boxformail.in": true,
-	"boximail.com": true,
-	"boxtemp.com.br": true,
-	"breadtimes.press": true,
-	"brefmail.com": true,
-	"brennendesreich.de": true,
-	"broadbandninja.com": true,
-	"browniesgoreng.com": true,
-	"brownieskukuskreasi.com": true,
-	"brownieslumer.com": true,
-	"bsnow.net": true,
-	"bst-72.com": true,
-	"btcmail.pw": true,
-	"bu.mintemail.com": true,
-	"buffemail.com": true,
-	"bugmenot.com": true,
-	"bumpymail.com": true,
-	"bund.us": true,
-	"bundes-li.ga": true,
-	"burnthespam.info": true,
-	"burstmail.info": true,
-	"buxap.com": true,
-	"buyusedlibrarybooks.org": true,
-	"byom.de": true,
-	"c.andreihusanu.ro": true,
-	"c.hcac.net": true,
-	"c.wlist.ro": true,
-	"c2.hu": true,
-	"c4utar.ml": true,
-	"c51vsgq.com": true,
-	"cachedot.net": true,
-	"car101.pro": true,
-	"cartelera.org": true,
-	"casualdx.com": true,
-	"cbair.com": true,
-	"ce.mintemail.com": true,
-	"cellurl.com": true,
-	"centermail.com": true,
-	"centermail.net": true,
-	"cetpass.com": true,
-	"chacuo.net": true,
-	"chammy.info": true,
-	"cheatmail.de": true,
-	"chechnya.conf.work": true,
-	"chogmail.com": true,
-	"choicemail1.com": true,
-	"chong-mail.com": true,
-	"chong-mail.net": true,
-	"chong-mail.org": true,
-	"citroen-c1.ml": true,
-	"ckaazaza.tk": true,
-	"clixser.com": true,
-	"clrmail.com": true,
-	"clubfier.com": true,
-	"cmail.com": true,
-	"cmail.net": true,
-	"cmail.org": true,
-	"cnn.coms.hk": true,
-	"cobarekyo1.ml": true,
-	"cocodani.cf": true,
-	"colafanta.cf": true,
-	"coldemail.info": true,
-	"consumerriot.com": true,
-	"contrasto.cu.cc": true,
-	"cool.fr.nf": true,
-	"correo.blogos.net": true,
-	"cosmorph.com": true,
-	"courriel.fr.nf": true,
-	"courrieltemporaire.com": true,
-	"crankmails.com": true,
-	"crapmail.org": true,
-	"crazespaces.pw": true,
-	"crazymailing.com": true,
-	"cream.pink": true,
-	"crotslep.ml": true,
-	"crotslep.tk": true,
-	"cubiclink.com": true,
-	"curryworld.de": true,
-	"cust.in": true,
-	"cuvox.de": true,
-	"cx.de-a.org": true,
-	"cyber-innovation.club": true,
-	"cyber-phone.eu": true,
-	"dacoolest.com": true,
-	"daintly.com": true,
-	"dandikmail.com": true,
-	"dasdasdascyka.tk": true,
-	"dayrep.com": true,
-	"dbunker.com": true,
-	"dcemail.com": true,
-	"de-fake.instafly.cf": true,
-	"de-fake.webfly.cf": true,
-	"deadaddress.com": true,
-	"deadchildren.org": true,
-	"deadfake.cf": true,
-	"deadfake.ga": true,
-	"deadfake.ml": true,
-	"deadfake.tk": true,
-	"deadspam.com": true,
-	"deagot.com": true,
-	"dealja.com": true,
-	"despam.it": true,
-	"despammed.com": true,
-	"devnullmail.com": true,
-	"dfgh.net": true,
-	"dfghj.ml": true,
-	"dharmatel.net": true,
-	"digitalsanctuary.com": true,
-	"dingbone.com": true,
-	"discard-email.cf": true,
-	"discard.cf": true,
-	"discard.email": true,
-	"discard.ga": true,
-	"discard.gq": true,
-	"discard.ml": true,
-	"discard.tk": true,
-	"discardmail.com": true,
-	"discardmail.de": true,
-	"disign-concept.eu": true,
-	"disign-revelation.com": true,
-	"dispomail.eu": true,
-	"disposable-email.ml": true,
-	"disposable.cf": true,
-	"disposable.ga": true,
-	"disposable.ml": true,
-	"disposableaddress.com": true,
+	"0-mail.com":                   true,
+	"027168.com":                   true,
+	"0815.ru":                      true,
+	"0815.su":                      true,
+	"0clickemail.com":              true,
+	"0wnd.net":                     true,
+	"0wnd.org":                     true,
+	"10mail.org":                   true,
+	"10minutemail.cf":              true,
+	"10minutemail.co.za":           true,
+	"10minutemail.com":             true,
+	"10minutemail.de":              true,
+	"10minutemail.ga":              true,
+	"10minutemail.gq":              true,
+	"10minutemail.ml":              true,
+	"10minutemail.net":             true,
+	"10minutemail.us":              true,
+	"10minutenemail.de":            true,
+	"123-m.com":                    true,
+	"12minutemail.com":             true,
+	"1ce.us":                       true,
+	"1chuan.com":                   true,
+	"1clck2.com":                   true,
+	"1mail.ml":                     true,
+	"1pad.de":                      true,
+	"1secmail.com":                 true,
+	"1secmail.net":                 true,
+	"1secmail.org":                 true,
+	"1up.orangotango.gq":           true,
+	"1zhuan.com":                   true,
+	"2-ch.space":                   true,
+	"20email.eu":                   true,
+	"20mail.in":                    true,
+	"20mail.it":                    true,
+	"20minute.email":               true,
+	"20minutemail.com":             true,
+	"21cn.com":                     true,
+	"225522.ml":                    true,
+	"24hourmail.com":               true,
+	"2ch.coms.hk":                  true,
+	"2prong.com":                   true,
+	"30minutemail.com":             true,
+	"30wave.com":                   true,
+	"33mail.com":                   true,
+	"3d-painting.com":              true,
+	"3mail.ga":                     true,
+	"44556677.igg.biz":             true,
+	"466453.usa.cc":                true,
+	"4mail.cf":                     true,
+	"4mail.ga":                     true,
+	"4warding.com":                 true,
+	"4warding.net":                 true,
+	"4warding.org":                 true,
+	"5mail.cf":                     true,
+	"5mail.ga":                     true,
+	"60minutemail.com":             true,
+	"675hosting.com":               true,
+	"675hosting.net":               true,
+	"675hosting.org":               true,
+	"69-ew.tk":                     true,
+	"6ip.us":                       true,
+	"6mail.cf":                     true,
+	"6mail.ga":                     true,
+	"6mail.ml":                     true,
+	"6paq.com":                     true,
+	"6url.com":                     true,
+	"75hosting.com":                true,
+	"75hosting.net":                true,
+	"75hosting.org":                true,
+	"7days-printing.com":           true,
+	"7ddf32e.info":                 true,
+	"7mail.ga":                     true,
+	"7mail.ml":                     true,
+	"7tags.com":                    true,
+	"7uy35p.tk":                    true,
+	"8mail.cf":                     true,
+	"8mail.ga":                     true,
+	"8mail.ml":                     true,
+	"99experts.com":                true,
+	"9mail.cf":                     true,
+	"9me.site":                     true,
+	"9ox.net":                      true,
+	"a-bc.net":                     true,
+	"a.betr.co":                    true,
+	"a.wxnw.net":                   true,
+	"a0.igg.biz":                   true,
+	"a1.usa.cc":                    true,
+	"a2.flu.cc":                    true,
+	"a45.in":                       true,
+	"abusemail.de":                 true,
+	"abyssmail.com":                true,
+	"ac20mail.in":                  true,
+	"acentri.com":                  true,
+	"adbet.co":                     true,
+	"add3000.pp.ua":                true,
+	"adrianou.gq":                  true,
+	"advantimo.com":                true,
+	"afrobacon.com":                true,
+	"ag.us.to":                     true,
+	"agedmail.com":                 true,
+	"ahk.jp":                       true,
+	"ajaxapp.net":                  true,
+	"alivance.com":                 true,
+	"amail.com":                    true,
+	"amilegit.com":                 true,
+	"amiri.net":                    true,
+	"amiriindustries.com":          true,
+	"anappthat.com":                true,
+	"ano-mail.net":                 true,
+	"anon.leemail.me":              true,
+	"anonbox.net":                  true,
+	"anonymail.dk":                 true,
+	"anonymbox.com":                true,
+	"anonymize.com":                true,
+	"anotherdomaincyka.tk":         true,
+	"antichef.com":                 true,
+	"antichef.net":                 true,
+	"antispam.de":                  true,
+	"antonelli.usa.cc":             true,
+	"apkmd.com":                    true,
+	"appixie.com":                  true,
+	"armyspy.com":                  true,
+	"art-en-ligne.pro":             true,
+	"arur01.tk":                    true,
+	"arurgitu.gq":                  true,
+	"arurimport.ml":                true,
+	"asdasd.nl":                    true,
+	"asiarap.usa.cc":               true,
+	"ass.pp.ua":                    true,
+	"aver.com":                     true,
+	"avia-tonic.fr":                true,
+	"ay33rs.flu.cc":                true,
+	"azazazatashkent.tk":           true,
+	"azmeil.tk":                    true,
+	"b0.nut.cc":                    true,
+	"babau.cf":                     true,
+	"babau.flu.cc":                 true,
+	"babau.ga":                     true,
+	"babau.gq":                     true,
+	"babau.igg.biz":                true,
+	"babau.ml":                     true,
+	"babau.nut.cc":                 true,
+	"babau.usa.cc":                 true,
+	"bareed.ws":                    true,
+	"barryogorman.com":             true,
+	"baxomale.ht.cx":               true,
+	"bccto.me":                     true,
+	"bdmuzic.pw":                   true,
+	"beddly.com":                   true,
+	"beefmilk.com":                 true,
+	"belastingdienst.pw":           true,
+	"big1.us":                      true,
+	"bigprofessor.so":              true,
+	"bigstring.com":                true,
+	"binka.me":                     true,
+	"binkmail.com":                 true,
+	"bio-muesli.net":               true,
+	"bione.co":                     true,
+	"bladesmail.net":               true,
+	"blogmyway.org":                true,
+	"bloxter.cu.cc":                true,
+	"blutig.me":                    true,
+	"bobmail.info":                 true,
+	"bodhi.lawlita.com":            true,
+	"bofthew.com":                  true,
+	"bongobongo.cf":                true,
+	"bongobongo.flu.cc":            true,
+	"bongobongo.ga":                true,
+	"bongobongo.igg.biz":           true,
+	"bongobongo.ml":                true,
+	"bongobongo.nut.cc":            true,
+	"bongobongo.tk":                true,
+	"bongobongo.usa.cc":            true,
+	"bootybay.de":                  true,
+	"boun.cr":                      true,
+	"bouncr.com":                   true,
+	"boxformail.in":                true,
+	"boximail.com":                 true,
+	"boxtemp.com.br":               true,
+	"breadtimes.press":             true,
+	"brefmail.com":                 true,
+	"brennendesreich.de":           true,
+	"broadbandninja.com":           true,
+	"browniesgoreng.com":           true,
+	"brownieskukuskreasi.com":      true,
+	"brownieslumer.com":            true,
+	"bsnow.net":                    true,
+	"bst-72.com":                   true,
+	"btcmail.pw":                   true,
+	"bu.mintemail.com":             true,
+	"buffemail.com":                true,
+	"bugmenot.com":                 true,
+	"bumpymail.com":                true,
+	"bund.us":                      true,
+	"bundes-li.ga":                 true,
+	"burnthespam.info":             true,
+	"burstmail.info":               true,
+	"buxap.com":                    true,
+	"buyusedlibrarybooks.org":      true,
+	"byom.de":                      true,
+	"c.andreihusanu.ro":            true,
+	"c.hcac.net":                   true,
+	"c.wlist.ro":                   true,
+	"c2.hu":                        true,
+	"c4utar.ml":                    true,
+	"c51vsgq.com":                  true,
+	"cachedot.net":                 true,
+	"car101.pro":                   true,
+	"cartelera.org":                true,
+	"casualdx.com":                 true,
+	"cbair.com":                    true,
+	"ce.mintemail.com":             true,
+	"cellurl.com":                  true,
+	"centermail.com":               true,
+	"centermail.net":               true,
+	"cetpass.com":                  true,
+	"chacuo.net":                   true,
+	"chammy.info":                  true,
+	"cheatmail.de":                 true,
+	"chechnya.conf.work":           true,
+	"chogmail.com":                 true,
+	"choicemail1.com":              true,
+	"chong-mail.com":               true,
+	"chong-mail.net":               true,
+	"chong-mail.org":               true,
+	"citroen-c1.ml":                true,
+	"ckaazaza.tk":                  true,
+	"clixser.com":                  true,
+	"clrmail.com":                  true,
+	"clubfier.com":                 true,
+	"cmail.com":                    true,
+	"cmail.net":                    true,
+	"cmail.org":                    true,
+	"cnn.coms.hk":                  true,
+	"cobarekyo1.ml":                true,
+	"cocodani.cf":                  true,
+	"colafanta.cf":                 true,
+	"coldemail.info":               true,
+	"consumerriot.com":             true,
+	"contrasto.cu.cc":              true,
+	"cool.fr.nf":                   true,
+	"correo.blogos.net":            true,
+	"cosmorph.com":                 true,
+	"courriel.fr.nf":               true,
+	"courrieltemporaire.com":       true,
+	"crankmails.com":               true,
+	"crapmail.org":                 true,
+	"crazespaces.pw":               true,
+	"crazymailing.com":             true,
+	"cream.pink":                   true,
+	"crotslep.ml":                  true,
+	"crotslep.tk":                  true,
+	"cubiclink.com":                true,
+	"curryworld.de":                true,
+	"cust.in":                      true,
+	"cuvox.de":                     true,
+	"cx.de-a.org":                  true,
+	"cyber-innovation.club":        true,
+	"cyber-phone.eu":               true,
+	"dacoolest.com":                true,
+	"daintly.com":                  true,
+	"dandikmail.com":               true,
+	"dasdasdascyka.tk":             true,
+	"dayrep.com":                   true,
+	"dbunker.com":                  true,
+	"dcemail.com":                  true,
+	"de-fake.instafly.cf":          true,
+	"de-fake.webfly.cf":            true,
+	"deadaddress.com":              true,
+	"deadchildren.org":             true,
+	"deadfake.cf":                  true,
+	"deadfake.ga":                  true,
+	"deadfake.ml":                  true,
+	"deadfake.tk":                  true,
+	"deadspam.com":                 true,
+	"deagot.com":                   true,
+	"dealja.com":                   true,
+	"despam.it":                    true,
+	"despammed.com":                true,
+	"devnullmail.com":              true,
+	"dfgh.net":                     true,
+	"dfghj.ml":                     true,
+	"dharmatel.net":                true,
+	"digitalsanctuary.com":         true,
+	"dingbone.com":                 true,
+	"discard-email.cf":             true,
+	"discard.cf":                   true,
+	"discard.email":                true,
+	"discard.ga":                   true,
+	"discard.gq":                   true,
+	"discard.ml":                   true,
+	"discard.tk":                   true,
+	"discardmail.com":              true,
+	"discardmail.de":               true,
+	"disign-concept.eu":            true,
+	"disign-revelation.com":        true,
+	"dispomail.eu":                 true,
+	"disposable-email.ml":          true,
+	"disposable.cf":                true,
+	"disposable.ga":                true,
+	"disposable.ml":                true,
+	"disposableaddress.com":        true,
 	"disposableemailaddresses.com": true,
 	"disposableemailaddresses.emailmiser.com": true,
-	"disposableinbox.com": true,
-	"dispose.it": true,
-	"disposeamail.com": true,
-	"disposemail.com": true,
-	"dispostable.com": true,
-	"divermail.com": true,
-	"divismail.ru": true,
-	"dlemail.ru": true,
-	"dm.w3internet.co.uk": true,
-	"dodgeit.com": true,
-	"dodgit.com": true,
-	"dodgit.org": true,
-	"dodsi.com": true,
-	"doiea.com": true,
-	"domforfb1.tk": true,
-	"domforfb2.tk": true,
-	"domforfb3.tk": true,
-	"domforfb4.tk": true,
-	"domforfb5.tk": true,
-	"domforfb6.tk": true,
-	"domforfb7.tk": true,
-	"domforfb8.tk": true,
-	"domforfb9.tk": true,
-	"domozmail.com": true,
-	"donemail.ru": true,
-	"dontreg.com": true,
-	"dontsendmespam.de": true,
-	"dot-ml.ml": true,
-	"dot-ml.tk": true,
-	"dotmsg.com": true,
-	"dr69.site": true,
-	"drdrb.com": true,
-	"drdrb.net": true,
-	"drivetagdev.com": true,
-	"droplar.com": true,
-	"dropmail.me": true,
-	"duam.net": true,
-	"dudmail.com": true,
-	"dump-email.info": true,
-	"dumpandjunk.com": true,
-	"dumpmail.de": true,
-	"dumpyemail.com": true,
-	"duskmail.com": true,
-	"dw.now.im": true,
-	"dx.abuser.eu": true,
-	"dx.allowed.org": true,
-	"dx.awiki.org": true,
-	"dx.ez.lv": true,
-	"dx.sly.io": true,
-	"e-mail.com": true,
-	"e-mail.org": true,
-	"e.arno.fi": true,
-	"e.blogspam.ro": true,
-	"e.discard-email.cf": true,
-	"e.milavitsaromania.ro": true,
-	"e.wupics.com": true,
-	"e0yk-mail.ml": true,
-	"e4ward.com": true,
-	"easytrashmail.com": true,
-	"ecolo-online.fr": true,
-	"ee2.pl": true,
-	"eelmail.com": true,
-	"einrot.com": true,
-	"einrot.de": true,
-	"email-fake.cf": true,
-	"email-fake.ga": true,
-	"email-fake.gq": true,
-	"email-fake.ml": true,
-	"email-fake.tk": true,
-	"email.cbes.net": true,
-	"email60.com": true,
-	"emailage.cf": true,
-	"emailage.ga": true,
-	"emailage.gq": true,
-	"emailage.ml": true,
-	"emailage.tk": true,
-	"emaildienst.de": true,
-	"emailgo.de": true,
-	"emailias.com": true,
-	"emailigo.de": true,
-	"emailinfive.com": true,
-	"emailisvalid.com": true,
-	"emaillime.com": true,
-	"emailmiser.com": true,
-	"emailproxsy.com": true,
-	"emails.ga": true,
-	"emailsensei.com": true,
-	"emailspam.cf": true,
-	"emailspam.ga": true,
-	"emailspam.gq": true,
-	"emailspam.ml": true,
-	"emailspam.tk": true,
-	"emailtemporar.ro": true,
-	"emailtemporario.com.br": true,
-	"emailthe.net": true,
-	"emailtmp.com": true,
-	"emailto.de": true,
-	"emailwarden.com": true,
-	"emailx.at.hm": true,
-	"emailxfer.com": true,
-	"emailz.cf": true,
-	"emailz.ga": true,
-	"emailz.gq": true,
-	"emailz.ml": true,
-	"emeil.in": true,
-	"emeil.ir": true,
-	"emil.com": true,
-	"emkei.cf": true,
-	"emkei.ga": true,
-	"emkei.gq": true,
-	"emkei.ml": true,
-	"emkei.tk": true,
-	"eml.pp.ua": true,
-	"emltmp.com": true,
-	"emz.net": true,
-	"enterto.com": true,
-	"ephemail.net": true,
-	"eqiluxspam.ga": true,
-	"erasf.com": true,
-	"ese.kr": true,
-	"est.une.victime.ninja": true,
-	"estate-invest.fr": true,
-	"etranquil.com": true,
-	"etranquil.net": true,
-	"etranquil.org": true,
-	"eu.igg.biz": true,
-	"everytg.ml": true,
-	"evopo.com": true,
-	"explodemail.com": true,
-	"eyepaste.com": true,
-	"ezlo.co": true,
-	"f5.si": true,
-	"facebook-email.cf": true,
-	"facebook-email.ga": true,
-	"facebook-email.ml": true,
-	"facebookmail.gq": true,
-	"facebookmail.ml": true,
-	"fake-box.com": true,
-	"fake-email.pp.ua": true,
-	"fake-mail.cf": true,
-	"fake-mail.ga": true,
-	"fake-mail.ml": true,
-	"fake.i-3gk.cf": true,
-	"fake.i-3gk.ga": true,
-	"fake.i-3gk.gq": true,
-	"fake.i-3gk.ml": true,
-	"fakeinbox.cf": true,
-	"fakeinbox.com": true,
-	"fakeinbox.ga": true,
-	"fakeinbox.ml": true,
-	"fakeinbox.tk": true,
-	"fakeinformation.com": true,
-	"fakemail.fr": true,
-	"fakemailgenerator.com": true,
-	"fakemailz.com": true,
-	"fammix.com": true,
-	"fansworldwide.de": true,
-	"fantasymail.de": true,
-	"fast-mail.fr": true,
-	"fastacura.com": true,
-	"fastchevy.com": true,
-	"fastchrysler.com": true,
-	"fastkawasaki.com": true,
-	"fastmazda.com": true,
-	"fastmitsubishi.com": true,
-	"fastnissan.com": true,
-	"fastsubaru.com": true,
-	"fastsuzuki.com": true,
-	"fasttoyota.com": true,
-	"fastyamaha.com": true,
-	"fatflap.com": true,
-	"fbi.coms.hk": true,
-	"fbmail1.ml": true,
-	"fdfdsfds.com": true,
-	"fiat-500.ga": true,
-	"ficken.de": true,
-	"fightallspam.com": true,
-	"fiifke.de": true,
-	"filzmail.com": true,
-	"fixmail.tk": true,
-	"fizmail.com": true,
-	"flashbox.5july.org": true,
-	"fleckens.hu": true,
-	"flemail.ru": true,
-	"flurred.com": true,
-	"flyspam.com": true,
-	"foodbooto.com": true,
-	"footard.com": true,
-	"forgetmail.com": true,
-	"fornow.eu": true,
-	"forward.cat": true,
-	"fr33mail.info": true,
-	"fragolina2.tk": true,
-	"frapmail.com": true,
-	"frappina.tk": true,
-	"frappina99.tk": true,
-	"free-email.cf": true,
-	"free-email.ga": true,
-	"freelance-france.eu": true,
-	"freemail.ms": true,
-	"freemail.tweakly.net": true,
-	"freemails.cf": true,
-	"freemails.ga": true,
-	"freemails.ml": true,
-	"freemeil.ga": true,
-	"freemeil.gq": true,
-	"freemeil.ml": true,
-	"freundin.ru": true,
-	"friendlymail.co.uk": true,
-	"front14.org": true,
-	"fuckingduh.com": true,
-	"fudgerub.com": true,
-	"fulvie.com": true,
-	"fun64.com": true,
-	"fuwamofu.com": true,
-	"fux0ringduh.com": true,
-	"fw.moza.pl": true,
-	"g.hmail.us": true,
-	"gamno.config.work": true,
-	"garliclife.com": true,
-	"gawab.com": true,
-	"gelitik.in": true,
-	"get-mail.cf": true,
-	"get-mail.ga": true,
-	"get-mail.ml": true,
-	"get-mail.tk": true,
-	"get.pp.ua": true,
-	"get1mail.com": true,
-	"get2mail.fr": true,
-	"getairmail.cf": true,
-	"getairmail.com": true,
-	"getairmail.ga": true,
-	"getairmail.gq": true,
-	"getairmail.ml": true,
-	"getairmail.tk": true,
-	"getmails.eu": true,
-	"getnada.com": true,
-	"getonemail.com": true,
-	"getonemail.net": true,
-	"ghosttexter.de": true,
-	"girlsundertheinfluence.com": true,
-	"gishpuppy.com": true,
-	"glubex.com": true,
-	"go.irc.so": true,
-	"go2usa.info": true,
-	"godut.com": true,
-	"goemailgo.com": true,
-	"goooogle.flu.cc": true,
-	"goooogle.igg.biz": true,
-	"goooogle.nut.cc": true,
-	"goooogle.usa.cc": true,
-	"gorillaswithdirtyarmpits.com": true,
-	"gotmail.com": true,
-	"gotmail.net": true,
-	"gotmail.org": true,
-	"gotti.otherinbox.com": true,
-	"gowikibooks.com": true,
-	"gowikicampus.com": true,
-	"gowikicars.com": true,
-	"gowikifilms.com": true,
-	"gowikigames.com": true,
-	"gowikimusic.com": true,
-	"gowikinetwork.com": true,
-	"gowikitravel.com": true,
-	"gowikitv.com": true,
-	"grandmamail.com": true,
-	"grandmasmail.com": true,
-	"great-host.in": true,
-	"greensloth.com": true,
-	"grr.la": true,
-	"gsrv.co.uk": true,
-	"guerillamail.biz": true,
-	"guerillamail.com": true,
-	"guerillamail.net": true,
-	"guerillamail.org": true,
-	"guerrillamail.biz": true,
-	"guerrillamail.com": true,
-	"guerrillamail.de": true,
-	"guerrillamail.info": true,
-	"guerrillamail.net": true,
-	"guerrillamail.org": true,
-	"guerrillamailblock.com": true,
-	"gustr.com": true,
-	"h.mintemail.com": true,
-	"h8s.org": true,
-	"hacccc.com": true,
-	"haltospam.com": true,
-	"harakirimail.com": true,
-	"haribu.net": true,
-	"hartbot.de": true,
-	"hasanmail.ml": true,
-	"hatespam.org": true,
-	"hellodream.mobi": true,
-	"herp.in": true,
-	"hezll.com": true,
-	"hidemail.de": true,
-	"hidemail.pro": true,
-	"hidemail.us": true,
-	"hidzz.com": true,
-	"hmamail.com": true,
-	"hochsitze.com": true,
-	"hoer.pw": true,
-	"hopemail.biz": true,
-	"horvathurtablahoz.ml": true,
-	"hostcalls.com": true,
-	"hot-mail.cf": true,
-	"hot-mail.ga": true,
-	"hot-mail.gq": true,
-	"hot-mail.ml": true,
-	"hot-mail.tk": true,
-	"hotpop.com": true,
-	"housat.com": true,
-	"hstermail.com": true,
-	"hukkmu.tk": true,
-	"hulapla.de": true,
-	"humn.ws.gy": true,
-	"hunrap.usa.cc": true,
-	"i.istii.ro": true,
-	"i.klipp.su": true,
-	"i.wawi.es": true,
-	"i.xcode.ro": true,
-	"i2pmail.org": true,
-	"ichigo.me": true,
-	"ieatspam.eu": true,
-	"ieatspam.info": true,
-	"ieh-mail.de": true,
-	"ihateyoualot.info": true,
-	"ihazspam.ca": true,
-	"iheartspam.org": true,
-	"ikbenspamvrij.nl": true,
-	"imails.info": true,
-	"imgof.com": true,
-	"imgv.de": true,
-	"immo-gerance.info": true,
-	"imstations.com": true,
-	"inbax.tk": true,
-	"inbound.plus": true,
-	"inbox.si": true,
-	"inboxalias.com": true,
-	"inboxbear.com": true,
-	"inboxclean.com": true,
-	"inboxclean.org": true,
-	"inboxproxy.com": true,
-	"inclusiveprogress.com": true,
-	"incognitomail.com": true,
-	"incognitomail.net": true,
-	"incognitomail.org": true,
-	"infest.org": true,
-	"info-radio.ml": true,
-	"inmynetwork.tk": true,
-	"insorg-mail.info": true,
-	"instant-mail.de": true,
-	"instantemailaddress.com": true,
-	"instantmail.fr": true,
-	"ip4.pp.ua": true,
-	"ip6.pp.ua": true,
-	"ipoo.org": true,
-	"irish2me.com": true,
-	"iroid.com": true,
-	"isdaq.com": true,
-	"italia.flu.cc": true,
-	"italia.igg.biz": true,
-	"itmtx.com": true,
-	"itsme.edu.pl": true,
-	"iwi.net": true,
-	"jcpclothing.ga": true,
-	"je-recycle.info": true,
-	"jet-renovation.fr": true,
-	"jetable.com": true,
-	"jetable.fr.nf": true,
-	"jetable.net": true,
-	"jetable.org": true,
-	"jetable.pp.ua": true,
-	"jnxjn.com": true,
-	"jobbikszimpatizans.hu": true,
-	"jourrapide.com": true,
-	"jp.ftp.sh": true,
-	"jsrsolutions.com": true,
-	"junk1e.com": true,
-	"junkmail.ga": true,
-	"junkmail.gq": true,
-	"jwk4227ufn.com": true,
-	"k.fido.be": true,
-	"kachadresp.tk": true,
-	"kanker.website": true,
-	"kasmail.com": true,
-	"kaspop.com": true,
-	"kazelink.ml": true,
-	"keepmymail.com": true,
-	"keinpardon.de": true,
-	"kemska.pw": true,
-	"killmail.com": true,
-	"killmail.net": true,
-	"kimsdisk.com": true,
-	"kingsq.ga": true,
-	"kir.ch.tc": true,
-	"klassmaster.com": true,
-	"klassmaster.net": true,
-	"klzlk.com": true,
-	"knol-power.nl": true,
-	"kook.ml": true,
-	"koszmail.pl": true,
-	"kuatcak.cf": true,
-	"kuatcak.tk": true,
-	"kuatmail.gq": true,
-	"kuatmail.tk": true,
-	"kulturbetrieb.info": true,
-	"kurzepost.de": true,
-	"kusrc.com": true,
-	"l33r.eu": true,
-	"labetteraverouge.at": true,
-	"lackmail.net": true,
-	"lackmail.ru": true,
-	"lags.us": true,
-	"lajoska.pe.hu": true,
-	"landmail.co": true,
-	"laoeq.com": true,
-	"laoho.com": true,
-	"last-chance.pro": true,
-	"lastmail.co": true,
-	"lastmail.com": true,
-	"lazyinbox.com": true,
-	"leeching.net": true,
-	"legalrc.loan": true,
-	"letthemeatspam.com": true,
-	"lhsdv.com": true,
-	"lifebyfood.com": true,
-	"link2mail.net": true,
-	"linkedintuts2016.pw": true,
-	"litedrop.com": true,
-	"liveradio.tk": true,
-	"loadby.us": true,
-	"loan101.pro": true,
-	"login-email.cf": true,
-	"login-email.ga": true,
-	"login-email.ml": true,
-	"login-email.tk": true,
-	"loh.pp.ua": true,
-	"lol.ovpn.to": true,
-	"lolfreak.net": true,
-	"lolito.tk": true,
-	"lookugly.com": true,
-	"lopl.co.cc": true,
-	"lortemail.dk": true,
-	"lovefall.ml": true,
-	"lovemeleaveme.com": true,
-	"lovesea.gq": true,
-	"lr7.us": true,
-	"lr78.com": true,
-	"lroid.com": true,
-	"luv2.us": true,
-	"m.ddcrew.com": true,
-	"m4ilweb.info": true,
-	"maboard.com": true,
-	"macr2.com": true,
-	"mail-easy.fr": true,
-	"mail-filter.com": true,
-	"mail-temporaire.fr": true,
-	"mail-tester.com": true,
-	"mail.backflip.cf": true,
-	"mail.by": true,
-	"mail.mezimages.net": true,
-	"mail.wtf": true,
-	"mail114.net": true,
-	"mail2rss.org": true,
-	"mail333.com": true,
-	"mail4trash.com": true,
-	"mailbidon.com": true,
-	"mailblocks.com": true,
-	"mailbox72.biz": true,
-	"mailbox80.biz": true,
-	"mailbucket.org": true,
-	"mailcat.biz": true,
-	"mailcatch.com": true,
-	"maildrop.cc": true,
-	"maildrop.cf": true,
-	"maildrop.ga": true,
-	"maildrop.gq": true,
-	"maildrop.ml": true,
-	"maildx.com": true,
-	"maileater.com": true,
-	"mailed.ro": true,
-	"maileme101.com": true,
-	"mailexpire.com": true,
-	"mailfa.tk": true,
-	"mailforspam.com": true,
-	"mailfree.ga": true,
-	"mailfree.gq": true,
-	"mailfree.ml": true,
-	"mailfreeonline.com": true,
-	"mailfs.com": true,
-	"mailguard.me": true,
-	"mailhero.io": true,
-	"mailimate.com": true,
-	"mailin8r.com": true,
-	"mailinatar.com": true,
-	"mailinater.com": true,
-	"mailinator.com": true,
-	"mailinator.gq": true,
-	"mailinator.net": true,
-	"mailinator.org": true,
-	"mailinator.us": true,
-	"mailinator2.com": true,
-	"mailincubator.com": true,
-	"mailismagic.com": true,
-	"mailjunk.cf": true,
-	"mailjunk.ga": true,
-	"mailjunk.gq": true,
-	"mailjunk.ml": true,
-	"mailjunk.tk": true,
-	"mailmate.com": true,
-	"mailme.gq": true,
-	"mailme.ir": true,
-	"mailme.lv": true,
-	"mailme24.com": true,
-	"mailmetrash.com": true,
-	"mailmoat.com": true,
-	"mailnator.com": true,
-	"mailnesia.com": true,
-	"mailnull.com": true,
-	"mailpick.biz": true,
-	"mailproxsy.com": true,
-	"mailquack.com": true,
-	"mailrock.biz": true,
-	"mailsac.com": true,
-	"mailscrap.com": true,
-	"mailseal.de": true,
-	"mailshell.com": true,
-	"mailsiphon.com": true,
-	"mailslapping.com": true,
-	"mailslite.com": true,
-	"mailspam.usa.cc": true,
-	"mailspam.xyz": true,
-	"mailtemp.info": true,
-	"mailtome.de": true,
-	"mailtothis.com": true,
-	"mailzi.ru": true,
-	"mailzilla.com": true,
-	"mailzilla.org": true,
-	"mailzilla.orgmbx.cc": true,
-	"makemetheking.com": true,
-	"manifestgenerator.com": true,
-	"manybrain.com": true,
-	"martin.securehost.com.es": true,
-	"materiali.ml": true,
-	"mbx.cc": true,
-	"mciek.com": true,
-	"mega.zik.dj": true,
-	"meinspamschutz.de": true,
-	"meltmail.com": true,
-	"merda.flu.cc": true,
-	"merda.igg.biz": true,
-	"merda.nut.cc": true,
-	"merda.usa.cc": true,
-	"merry.pink": true,
-	"messagebeamer.de": true,
-	"mezimages.net": true,
-	"mfsa.ru": true,
-	"mierdamail.com": true,
-	"migmail.net": true,
-	"migmail.pl": true,
-	"migumail.com": true,
-	"mintemail.com": true,
-	"mjukglass.nu": true,
-	"moakt.com": true,
-	"moakt.ws": true,
-	"mobi.web.id": true,
-	"mobileninja.co.uk": true,
-	"moburl.com": true,
-	"mohmal.com": true,
-	"mohmal.im": true,
-	"mohmal.in": true,
-	"mohmal.tech": true,
-	"moncourrier.fr.nf": true,
-	"monemail.fr.nf": true,
-	"monmail.fr.nf": true,
-	"monumentmail.com": true,
-	"mor19.uu.gl": true,
-	"morahdsl.cf": true,
-	"mox.pp.ua": true,
-	"mrblacklist.gq": true,
-	"mrresourcepacks.tk": true,
-	"ms9.mailslite.com": true,
-	"msa.minsmail.com": true,
-	"mt2009.com": true,
-	"mt2014.com": true,
-	"mt2015.com": true,
-	"mt2016.com": true,
-	"mt2017.com": true,
-	"muehlacker.tk": true,
-	"muq.orangotango.tk": true,
-	"mvrht.com": true,
-	"mx0.wwwnew.eu": true,
-	"my.efxs.ca": true,
-	"my.spam.orangotango.ml": true,
-	"my10minutemail.com": true,
-	"mycleaninbox.net": true,
-	"myemailboxy.com": true,
-	"mymail-in.net": true,
-	"mymailoasis.com": true,
-	"mymailto.cf": true,
-	"mymailto.ga": true,
-	"myneocards.cz": true,
-	"mynetstore.de": true,
-	"mypacks.net": true,
-	"mypartyclip.de": true,
-	"myphantomemail.com": true,
-	"myspaceinc.com": true,
-	"myspaceinc.net": true,
-	"myspaceinc.org": true,
-	"myspacepimpedup.com": true,
-	"myspamless.com": true,
-	"mytemp.email": true,
-	"mytempemail.com": true,
-	"mytrashmail.com": true,
-	"n.ra3.us": true,
-	"n.spamtrap.co": true,
-	"n.zavio.nl": true,
-	"napalm51.cf": true,
-	"napalm51.flu.cc": true,
-	"napalm51.ga": true,
-	"napalm51.gq": true,
-	"napalm51.igg.biz": true,
-	"napalm51.ml": true,
-	"napalm51.nut.cc": true,
-	"napalm51.tk": true,
-	"napalm51.usa.cc": true,
-	"neko2.net": true,
-	"neomailbox.com": true,
-	"nepwk.com": true,
-	"nervmich.net": true,
-	"nervtmich.net": true,
-	"netmails.com": true,
-	"netmails.net": true,
-	"netzidiot.de": true,
-	"neverbox.com": true,
-	"nezzart.com": true,
-	"nice-4u.com": true,
-	"nike.coms.hk": true,
-	"nmail.cf": true,
-	"no-spam.ws": true,
-	"nobulk.com": true,
-	"noclickemail.com": true,
-	"nogmailspam.info": true,
-	"nomail.xl.cx": true,
-	"nomail2me.com": true,
-	"nomorespamemails.com": true,
-	"nonspam.eu": true,
-	"nonspammer.de": true,
-	"noref.in": true,
-	"nospam.wins.com.br": true,
-	"nospam.ze.tc": true,
-	"nospam4.us": true,
-	"nospamfor.us": true,
-	"nospamthanks.info": true,
-	"notmailinator.com": true,
-	"notsharingmy.info": true,
-	"nowhere.org": true,
-	"nowmymail.com": true,
-	"ntlhelp.net": true,
-	"nurfuerspam.de": true,
-	"nus.edu.sg": true,
-	"nutpa.net": true,
-	"nwldx.com": true,
-	"nwytg.com": true,
-	"o.cfo2go.ro": true,
-	"o.oai.asia": true,
-	"o.opendns.ro": true,
-	"o.spamtrap.ro": true,
-	"objectmail.com": true,
-	"obobbo.com": true,
-	"odaymail.com": true,
-	"olypmall.ru": true,
-	"one-time.email": true,
-	"oneoffemail.com": true,
-	"oneoffmail.com": true,
-	"onewaymail.com": true,
-	"online.ms": true,
-	"oopi.org": true,
-	"opayq.com": true,
-	"opel-corsa.tk": true,
-	"opentrash.com": true,
-	"orango.cu.cc": true,
-	"ordinaryamerican.net": true,
-	"oshietechan.link": true,
-	"otherinbox.com": true,
-	"ourklips.com": true,
-	"outlawspam.com": true,
-	"ovpn.to": true,
-	"owlpic.com": true,
-	"p71ce1m.com": true,
-	"pagamenti.tk": true,
-	"paller.cf": true,
-	"pancakemail.com": true,
-	"paplease.com": true,
-	"parlimentpetitioner.tk": true,
-	"password.colafanta.cf": true,
-	"pcusers.otherinbox.com": true,
-	"pepbot.com": true,
-	"pepsi.coms.hk": true,
-	"pfui.ru": true,
-	"photo-impact.eu": true,
-	"phpbb.uu.gl": true,
-	"phus8kajuspa.cu.cc": true,
-	"pimpedupmyspace.com": true,
-	"pjjkp.com": true,
-	"plexolan.de": true,
-	"po.bot.nu": true,
-	"poh.pp.ua": true,
-	"pokemail.net": true,
-	"politikerclub.de": true,
-	"polyfaust.com": true,
-	"poofy.org": true,
-	"pookmail.com": true,
-	"porco.cf": true,
-	"porco.ga": true,
-	"porco.gq": true,
-	"porco.ml": true,
-	"postacin.com": true,
-	"ppetw.com": true,
-	"premium-mail.fr": true,
-	"privacy.net": true,
-	"privy-mail.com": true,
-	"privymail.de": true,
-	"project-xhabbo.com": true,
-	"proxymail.eu": true,
-	"prtnx.com": true,
-	"prtz.eu": true,
-	"psles.com": true,
-	"punkass.com": true,
-	"purple.flu.cc": true,
-	"purple.igg.biz": true,
-	"purple.nut.cc": true,
-	"purple.usa.cc": true,
-	"puttanamaiala.tk": true,
-	"putthisinyourspamdatabase.com": true,
-	"pw.flu.cc": true,
-	"pw.igg.biz": true,
-	"pw.nut.cc": true,
-	"pwrby.com": true,
-	"q5vm7pi9.com": true,
-	"qasti.com": true,
-	"qisdo.com": true,
-	"qisoa.com": true,
-	"qs.dp76.com": true,
-	"quickinbox.com": true,
-	"quickmail.nl": true,
-	"r8.porco.cf": true,
-	"radiku.ye.vc": true,
-	"rajeshcon.cf": true,
-	"rcpt.at": true,
-	"re-gister.com": true,
-	"reality-concept.club": true,
-	"reallymymail.com": true,
-	"receiveee.chickenkiller.com": true,
-	"receiveee.com": true,
-	"recode.me": true,
-	"reconmail.com": true,
-	"recursor.net": true,
-	"recyclemail.dk": true,
-	"reddit.usa.cc": true,
-	"regbypass.com": true,
-	"regbypass.comsafe-mail.net": true,
-	"regspaces.tk": true,
-	"rejectmail.com": true,
-	"remail.cf": true,
-	"remail.ga": true,
-	"renault-clio.cf": true,
-	"resgedvgfed.tk": true,
-	"retkesbusz.nut.cc": true,
-	"rhyta.com": true,
-	"rk9.chickenkiller.com": true,
-	"rklips.com": true,
-	"rkomo.com": true,
-	"rmqkr.net": true,
-	"rootfest.net": true,
-	"royal.net": true,
-	"rppkn.com": true,
-	"rtrtr.com": true,
-	"rudymail.ml": true,
-	"ruffrey.com": true,
-	"ruru.be": true,
-	"rx.dred.ru": true,
-	"rx.qc.to": true,
-	"s.bloq.ro": true,
-	"s.dextm.ro": true,
-	"s.proprietativalcea.ro": true,
-	"s.sa.igg.biz": true,
-	"s.spamserver.flu.cc": true,
-	"s.vdig.com": true,
-	"s00.orangotango.ga": true,
-	"s0ny.net": true,
-	"sa.igg.biz": true,
-	"safe-mail.net": true,
-	"safersignup.de": true,
-	"safetymail.info": true,
-	"safetypost.de": true,
-	"sandelf.de": true,
-	"savelife.ml": true,
-	"saynotospams.com": true,
-	"scatmail.com": true,
-	"schafmail.de": true,
-	"secure-mail.biz": true,
-	"secure-mail.cc": true,
-	"securehost.com.es": true,
-	"selfdestructingmail.com": true,
-	"selfdestructingmail.org": true,
-	"sendspamhere.com": true,
-	"servermaps.net": true,
-	"sfmail.top": true,
-	"sharedmailbox.org": true,
-	"sharklasers.com": true,
-	"shieldedmail.com": true,
-	"shiftmail.com": true,
-	"shitaway.cf": true,
-	"shitaway.cu.cc": true,
-	"shitaway.flu.cc": true,
-	"shitaway.ga": true,
-	"shitaway.gq": true,
-	"shitaway.igg.biz": true,
-	"shitaway.ml": true,
-	"shitaway.nut.cc": true,
-	"shitaway.tk": true,
-	"shitaway.usa.cc": true,
-	"shitmail.de": true,
-	"shitmail.me": true,
-	"shitmail.org": true,
-	"shitware.nl": true,
-	"shockinmytown.cu.cc": true,
-	"shortmail.net": true,
-	"shotmail.ru": true,
-	"showslow.de": true,
-	"shuffle.email": true,
-	"siliwangi.ga": true,
-	"sinnlos-mail.de": true,
-	"siteposter.net": true,
-	"skeefmail.com": true,
-	"skrx.tk": true,
-	"sky-mail.ga": true,
-	"slaskpost.se": true,
-	"slave-auctions.net": true,
-	"slippery.email": true,
-	"slipry.net": true,
-	"slopsbox.com": true,
-	"slushmail.com": true,
-	"smap.4nmv.ru": true,
-	"smashmail.de": true,
-	"smellfear.com": true,
-	"smellrear.com": true,
-	"snakemail.com": true,
-	"sneakemail.com": true,
-	"snkmail.com": true,
-	"social-mailer.tk": true,
-	"sofimail.com": true,
-	"sofort-mail.de": true,
-	"softpls.asia": true,
-	"sogetthis.com": true,
-	"sohu.com": true,
-	"soisz.com": true,
-	"solar-impact.pro": true,
-	"solvemail.info": true,
-	"soodomail.com": true,
-	"soodonims.com": true,
-	"spam-a.porco.cf": true,
-	"spam-b.porco.cf": true,
-	"spam-be-gone.com": true,
-	"spam.2012-2016.ru": true,
-	"spam.flu.cc": true,
-	"spam.igg.biz": true,
-	"spam.la": true,
-	"spam.nut.cc": true,
-	"spam.orangotango.ml": true,
-	"spam.su": true,
-	"spam.usa.cc": true,
-	"spam4.me": true,
-	"spamavert.com": true,
-	"spambob.com": true,
-	"spambob.net": true,
-	"spambob.org": true,
-	"spambog.com": true,
-	"spambog.de": true,
-	"spambog.net": true,
-	"spambog.ru": true,
-	"spambooger.com": true,
-	"spambox.info": true,
-	"spambox.irishspringrealty.com": true,
-	"spambox.us": true,
-	"spamcannon.com": true,
-	"spamcannon.net": true,
-	"spamcero.com": true,
-	"spamcon.org": true,
-	"spamcorptastic.com": true,
-	"spamcowboy.com": true,
-	"spamcowboy.net": true,
-	"spamcowboy.org": true,
-	"spamday.com": true,
-	"spamdecoy.net": true,
-	"spamex.com": true,
-	"spamfighter.cf": true,
-	"spamfighter.ga": true,
-	"spamfighter.gq": true,
-	"spamfighter.ml": true,
-	"spamfighter.tk": true,
-	"spamfree.eu": true,
-	"spamfree24.com": true,
-	"spamfree24.de": true,
-	"spamfree24.eu": true,
-	"spamfree24.info": true,
-	"spamfree24.net": true,
-	"spamfree24.org": true,
-	"spamgoes.in": true,
-	"spamgourmet.com": true,
-	"spamgourmet.net": true,
-	"spamgourmet.org": true,
-	"spamherelots.com": true,
-	"spamhereplease.com": true,
-	"spamhole.com": true,
-	"spamify.com": true,
-	"spaminator.de": true,
-	"spamkill.info": true,
-	"spaml.com": true,
-	"spaml.de": true,
-	"spammotel.com": true,
-	"spamobox.com": true,
-	"spamoff.de": true,
-	"spamsalad.in": true,
-	"spamserver.cf": true,
-	"spamserver.flu.cc": true,
-	"spamserver.ml": true,
-	"spamserver.tk": true,
-	"spamslicer.com": true,
-	"spamspot.com": true,
-	"spamstack.net": true,
-	"spamthis.co.uk": true,
-	"spamthisplease.com": true,
-	"spamtrail.com": true,
-	"spamtroll.net": true,
-	"speed.1s.fr": true,
-	"sperma.cf": true,
-	"spikio.com": true,
-	"spoofmail.de": true,
-	"spybox.de": true,
-	"squizzy.de": true,
-	"squizzy.net": true,
-	"sr.ro.lt": true,
-	"sraka.xyz": true,
-	"sroff.com": true,
-	"ss.undo.it": true,
-	"ssoia.com": true,
-	"startkeys.com": true,
-	"stexsy.com": true,
-	"stinkefinger.net": true,
-	"stop-my-spam.cf": true,
-	"stop-my-spam.com": true,
-	"stop-my-spam.ga": true,
-	"stop-my-spam.ml": true,
-	"stop-my-spam.pp.ua": true,
-	"stop-my-spam.tk": true,
-	"streetwisemail.com": true,
-	"stromox.com": true,
-	"stuffmail.de": true,
-	"sudolife.me": true,
-	"sudolife.net": true,
-	"sudomail.biz": true,
-	"sudomail.com": true,
-	"sudomail.net": true,
-	"sudoverse.com": true,
-	"sudoverse.net": true,
-	"sudoweb.net": true,
-	"sudoworld.com": true,
-	"sudoworld.net": true,
-	"supergreatmail.com": true,
-	"supermailer.jp": true,
-	"superrito.com": true,
-	"superstachel.de": true,
-	"suremail.info": true,
-	"susi.ml": true,
-	"svk.jp": true,
-	"sweetxxx.de": true,
-	"szerz.com": true,
-	"t.psh.me": true,
-	"tafmail.com": true,
-	"taglead.com": true,
-	"tagyourself.com": true,
-	"talkinator.com": true,
-	"tapchicuoihoi.com": true,
-	"tarzan.usa.cc": true,
-	"tarzanmail.cf": true,
-	"tarzanmail.ml": true,
-	"teamspeak3.ga": true,
-	"teewars.org": true,
-	"teleosaurs.xyz": true,
-	"teleworm.com": true,
-	"teleworm.us": true,
-	"temp-mail.com": true,
-	"temp-mail.de": true,
-	"temp-mail.org": true,
-	"temp.bartdevos.be": true,
-	"temp.emeraldwebmail.com": true,
-	"temp.headstrong.de": true,
-	"temp.mail.y59.jp": true,
-	"tempail.com": true,
-	"tempalias.com": true,
-	"tempe-mail.com": true,
-	"tempemail.biz": true,
-	"tempemail.co.za": true,
-	"tempemail.com": true,
-	"tempemail.net": true,
-	"tempinbox.co.uk": true,
-	"tempinbox.com": true,
-	"tempmail.co": true,
-	"tempmail.it": true,
-	"tempmail.pro": true,
-	"tempmail.us": true,
-	"tempmail2.com": true,
-	"tempmaildemo.com": true,
-	"tempmailer.com": true,
-	"tempomail.fr": true,
-	"temporarily.de": true,
-	"temporarioemail.com.br": true,
-	"temporaryemail.net": true,
-	"temporaryemail.us": true,
-	"temporaryforwarding.com": true,
-	"temporaryinbox.com": true,
-	"tempsky.com": true,
-	"tempthe.net": true,
-	"tempymail.com": true,
-	"thanksnospam.info": true,
-	"thankyou2010.com": true,
-	"thecloudindex.com": true,
-	"thereddoors.online": true,
-	"thisisnotmyrealemail.com": true,
-	"thraml.com": true,
-	"thrma.com": true,
-	"throam.com": true,
-	"thrott.com": true,
-	"throwam.com": true,
-	"throwawayemailaddress.com": true,
-	"throwawaymail.com": true,
-	"throya.com": true,
-	"tilien.com": true,
-	"tittbit.in": true,
-	"tm.tosunkaya.com": true,
-	"tmail.ws": true,
-	"tmailinator.com": true,
-	"toiea.com": true,
-	"toomail.biz": true,
-	"top9appz.info": true,
-	"tradermail.info": true,
-	"tralalajos.ga": true,
-	"tralalajos.gq": true,
-	"tralalajos.ml": true,
-	"tralalajos.tk": true,
-	"trash-amil.com": true,
-	"trash-mail.at": true,
-	"trash-mail.cf": true,
-	"trash-mail.com": true,
-	"trash-mail.de": true,
-	"trash-mail.ga": true,
-	"trash-mail.gq": true,
-	"trash-mail.ml": true,
-	"trash-mail.tk": true,
-	"trash-me.com": true,
-	"trash2009.com": true,
-	"trash2010.com": true,
-	"trash2011.com": true,
-	"trashcanmail.com": true,
-	"trashdevil.com": true,
-	"trashdevil.de": true,
-	"trashemail.de": true,
-	"trashmail.at": true,
-	"trashmail.com": true,
-	"trashmail.de": true,
-	"trashmail.me": true,
-	"trashmail.net": true,
-	"trashmail.org": true,
-	"trashmail.ws": true,
-	"trashmailer.com": true,
-	"trashymail.com": true,
-	"trashymail.net": true,
-	"trayna.com": true,
-	"trbvm.com": true,
-	"trbvn.com": true,
-	"trbvo.com": true,
-	"trickmail.net": true,
-	"trillianpro.com": true,
-	"trump.flu.cc": true,
-	"trump.igg.biz": true,
-	"tryalert.com": true,
-	"turoid.com": true,
-	"turual.com": true,
-	"tvchd.com": true,
-	"tverya.com": true,
-	"twinmail.de": true,
-	"twoweirdtricks.com": true,
-	"ty.ceed.se": true,
-	"tyldd.com": true,
-	"u.0u.ro": true,
-	"u.10x.es": true,
-	"u.2sea.org": true,
-	"u.900k.es": true,
-	"u.civvic.ro": true,
-	"u.dmarc.ro": true,
-	"u.labo.ch": true,
-	"u14269.ml": true,
-	"uacro.com": true,
-	"ubismail.net": true,
-	"ucupdong.ml": true,
-	"uggsrock.com": true,
-	"uk.flu.cc": true,
-	"uk.igg.biz": true,
-	"uk.nut.cc": true,
-	"umail.net": true,
-	"unmail.ru": true,
-	"upliftnow.com": true,
-	"uplipht.com": true,
-	"urfey.com": true,
-	"uroid.com": true,
-	"used-product.fr": true,
-	"username.e4ward.com": true,
-	"ux.dob.jp": true,
-	"ux.uk.to": true,
-	"v.0v.ro": true,
-	"v.jsonp.ro": true,
-	"vaasfc4.tk": true,
-	"valemail.net": true,
-	"venompen.com": true,
-	"veryrealemail.com": true,
-	"vfemail.net": true,
-	"vickaentb.tk": true,
-	"vidchart.com": true,
-	"viditag.com": true,
-	"viewcastmedia.com": true,
-	"viewcastmedia.net": true,
-	"viewcastmedia.org": true,
-	"viroleni.cu.cc": true,
-	"visa.coms.hk": true,
-	"vkcode.ru": true,
-	"vomoto.com": true,
-	"vp.ycare.de": true,
-	"vps30.com": true,
-	"vssms.com": true,
-	"vubby.com": true,
-	"vw-golf.gq": true,
-	"vzlom4ik.tk": true,
-	"w.0w.ro": true,
-	"walala.org": true,
-	"walkmail.net": true,
-	"walkmail.ru": true,
-	"wasd.dropmail.me": true,
-	"wazabi.club": true,
-	"we.qq.my": true,
-	"web-contact.info": true,
-	"web-emailbox.eu": true,
-	"web-ideal.fr": true,
-	"web-mail.pp.ua": true,
-	"web.discard-email.cf": true,
-	"webcontact-france.eu": true,
-	"webemail.me": true,
-	"webm4il.info": true,
-	"webuser.in": true,
-	"wee.my": true,
-	"wefjo.grn.cc": true,
-	"weg-werf-email.de": true,
-	"wegwerf-email-addressen.de": true,
-	"wegwerf-emails.de": true,
-	"wegwerfadresse.de": true,
-	"wegwerfemail.de": true,
-	"wegwerfmail.de": true,
-	"wegwerfmail.info": true,
-	"wegwerfmail.net": true,
-	"wegwerfmail.org": true,
-	"wegwerpmailadres.nl": true,
-	"wetrainbayarea.com": true,
-	"wetrainbayarea.org": true,
-	"wfgdfhj.tk": true,
-	"wh4f.org": true,
-	"whatiaas.com": true,
-	"whatpaas.com": true,
-	"whatsaas.com": true,
-	"whopy.com": true,
-	"whtjddn.33mail.com": true,
-	"whyspam.me": true,
-	"wickmail.net": true,
-	"wilemail.com": true,
-	"willselfdestruct.com": true,
-	"winemaven.info": true,
-	"wiz2.site": true,
-	"wmail.cf": true,
-	"wollan.info": true,
-	"worldspace.link": true,
-	"wovz.cu.cc": true,
-	"wr.moeri.org": true,
-	"wronghead.com": true,
-	"wt2.orangotango.cf": true,
-	"wuzup.net": true,
-	"wuzupmail.net": true,
-	"www.bccto.me": true,
-	"www.e4ward.com": true,
-	"www.gishpuppy.com": true,
-	"www.mailinator.com": true,
-	"wwwnew.eu": true,
-	"xagloo.com": true,
-	"xemaps.com": true,
-	"xents.com": true,
-	"xing886.uu.gl": true,
-	"xmaily.com": true,
-	"xoxox.cc": true,
-	"xoxy.net": true,
-	"xww.ro": true,
-	"xy9ce.tk": true,
-	"xyzfree.net": true,
-	"xzsok.com": true,
-	"yandere.cu.cc": true,
-	"yapped.net": true,
-	"yeah.net": true,
-	"yellow.flu.cc": true,
-	"yellow.hotakama.tk": true,
-	"yellow.igg.biz": true,
-	"yep.it": true,
-	"yert.ye.vc": true,
-	"yogamaven.com": true,
-	"yomail.info": true,
-	"yopmail.com": true,
-	"yopmail.fr": true,
-	"yopmail.gq": true,
-	"yopmail.net": true,
-	"yopmail.pp.ua": true,
-	"yordanmail.cf": true,
-	"you-spam.com": true,
-	"youmail.ga": true,
-	"yourlifesucks.cu.cc": true,
-	"ypmail.webarnak.fr.eu.org": true,
-	"yroid.com": true,
-	"yuurok.com": true,
-	"z1p.biz": true,
-	"za.com": true,
-	"zain.site": true,
-	"zainmax.net": true,
-	"zaktouni.fr": true,
-	"ze.gally.jp": true,
-	"zehnminutenmail.de": true,
-	"zeta-telecom.com": true,
-	"zetmail.com": true,
-	"zhcne.com": true,
-	"zhouemail.510520.org": true,
-	"zippymail.info": true,
-	"zoaxe.com": true,
-	"zoemail.com": true,
-	"zoemail.net": true,
-	"zoemail.org": true,
-	"zombo.flu.cc": true,
-	"zombo.igg.biz": true,
-	"zombo.nut.cc": true,
-	"zomg.info": true,
-	"zxcv.com": true,
-	"zxcvbnm.com": true,
-	"zzz.com": true,
+	"disposableinbox.com":                     true,
+	"dispose.it":                              true,
+	"disposeamail.com":                        true,
+	"disposemail.com":                         true,
+	"dispostable.com":                         true,
+	"divermail.com":                           true,
+	"divismail.ru":                            true,
+	"dlemail.ru":                              true,
+	"dm.w3internet.co.uk":                     true,
+	"dodgeit.com":                             true,
+	"dodgit.com":                              true,
+	"dodgit.org":                              true,
+	"dodsi.com":                               true,
+	"doiea.com":                               true,
+	"domforfb1.tk":                            true,
+	"domforfb2.tk":                            true,
+	"domforfb3.tk":                            true,
+	"domforfb4.tk":                            true,
+	"domforfb5.tk":                            true,
+	"domforfb6.tk":                            true,
+	"domforfb7.tk":                            true,
+	"domforfb8.tk":                            true,
+	"domforfb9.tk":                            true,
+	"domozmail.com":                           true,
+	"donemail.ru":                             true,
+	"dontreg.com":                             true,
+	"dontsendmespam.de":                       true,
+	"dot-ml.ml":                               true,
+	"dot-ml.tk":                               true,
+	"dotmsg.com":                              true,
+	"dr69.site":                               true,
+	"drdrb.com":                               true,
+	"drdrb.net":                               true,
+	"drivetagdev.com":                         true,
+	"droplar.com":                             true,
+	"dropmail.me":                             true,
+	"duam.net":                                true,
+	"dudmail.com":                             true,
+	"dump-email.info":                         true,
+	"dumpandjunk.com":                         true,
+	"dumpmail.de":                             true,
+	"dumpyemail.com":                          true,
+	"duskmail.com":                            true,
+	"dw.now.im":                               true,
+	"dx.abuser.eu":                            true,
+	"dx.allowed.org":                          true,
+	"dx.awiki.org":                            true,
+	"dx.ez.lv":                                true,
+	"dx.sly.io":                               true,
+	"e-mail.com":                              true,
+	"e-mail.org":                              true,
+	"e.arno.fi":                               true,
+	"e.blogspam.ro":                           true,
+	"e.discard-email.cf":                      true,
+	"e.milavitsaromania.ro":                   true,
+	"e.wupics.com":                            true,
+	"e0yk-mail.ml":                            true,
+	"e4ward.com":                              true,
+	"easytrashmail.com":                       true,
+	"ecolo-online.fr":                         true,
+	"ee2.pl":                                  true,
+	"eelmail.com":                             true,
+	"einrot.com":                              true,
+	"einrot.de":                               true,
+	"email-fake.cf":                           true,
+	"email-fake.ga":                           true,
+	"email-fake.gq":                           true,
+	"email-fake.ml":                           true,
+	"email-fake.tk":                           true,
+	"email.cbes.net":                          true,
+	"email60.com":                             true,
+	"emailage.cf":                             true,
+	"emailage.ga":                             true,
+	"emailage.gq":                             true,
+	"emailage.ml":                             true,
+	"emailage.tk":                             true,
+	"emaildienst.de":                          true,
+	"emailfake.com":                           true,
+	"emailgo.de":                              true,
+	"emailias.com":                            true,
+	"emailigo.de":                             true,
+	"emailinfive.com":                         true,
+	"emailisvalid.com":                        true,
+	"emaillime.com":                           true,
+	"emailmiser.com":                          true,
+	"emailondeck.com":                         true,
+	"emailproxsy.com":                         true,
+	"emails.ga":                               true,
+	"emailsensei.com":                         true,
+	"emailspam.cf":                            true,
+	"emailspam.ga":                            true,
+	"emailspam.gq":                            true,
+	"emailspam.ml":                            true,
+	"emailspam.tk":                            true,
+	"emailtemporar.ro":                        true,
+	"emailtemporario.com.br":                  true,
+	"emailthe.net":                            true,
+	"emailtmp.com":                            true,
+	"emailto.de":                              true,
+	"emailwarden.com":                         true,
+	"emailx.at.hm":                            true,
+	"emailxfer.com":                           true,
+	"emailz.cf":                               true,
+	"emailz.ga":                               true,
+	"emailz.gq":                               true,
+	"emailz.ml":                               true,
+	"emeil.in":                                true,
+	"emeil.ir":                                true,
+	"emil.com":                                true,
+	"emkei.cf":                                true,
+	"emkei.ga":                                true,
+	"emkei.gq":                                true,
+	"emkei.ml":                                true,
+	"emkei.tk":                                true,
+	"eml.pp.ua":                               true,
+	"emltmp.com":                              true,
+	"emz.net":                                 true,
+	"enterto.com":                             true,
+	"ephemail.net":                            true,
+	"eqiluxspam.ga":                           true,
+	"erasf.com":                               true,
+	"ese.kr":                                  true,
+	"est.une.victime.ninja":                   true,
+	"estate-invest.fr":                        true,
+	"etranquil.com":                           true,
+	"etranquil.net":                           true,
+	"etranquil.org":                           true,
+	"eu.igg.biz":                              true,
+	"everytg.ml":                              true,
+	"evopo.com":                               true,
+	"explodemail.com":                         true,
+	"eyepaste.com":                            true,
+	"ezlo.co":                                 true,
+	"f5.si":                                   true,
+	"facebook-email.cf":                       true,
+	"facebook-email.ga":                       true,
+	"facebook-email.ml":                       true,
+	"facebookmail.gq":                         true,
+	"facebookmail.ml":                         true,
+	"fake-box.com":                            true,
+	"fake-email.pp.ua":                        true,
+	"fake-mail.cf":                            true,
+	"fake-mail.ga":                            true,
+	"fake-mail.ml":                            true,
+	"fake.i-3gk.cf":                           true,
+	"fake.i-3gk.ga":                           true,
+	"fake.i-3gk.gq":                           true,
+	"fake.i-3gk.ml":                           true,
+	"fakeinbox.cf":                            true,
+	"fakeinbox.com":                           true,
+	"fakeinbox.ga":                            true,
+	"fakeinbox.ml":                            true,
+	"fakeinbox.tk":                            true,
+	"fakeinformation.com":                     true,
+	"fakemail.fr":                             true,
+	"fakemailgenerator.com":                   true,
+	"fakemailz.com":                           true,
+	"fammix.com":                              true,
+	"fansworldwide.de":                        true,
+	"fantasymail.de":                          true,
+	"fast-mail.fr":                            true,
+	"fastacura.com":                           true,
+	"fastchevy.com":                           true,
+	"fastchrysler.com":                        true,
+	"fastkawasaki.com":                        true,
+	"fastmazda.com":                           true,
+	"fastmitsubishi.com":                      true,
+	"fastnissan.com":                          true,
+	"fastsubaru.com":                          true,
+	"fastsuzuki.com":                          true,
+	"fasttoyota.com":                          true,
+	"fastyamaha.com":                          true,
+	"fatflap.com":                             true,
+	"fbi.coms.hk":                             true,
+	"fbmail1.ml":                              true,
+	"fdfdsfds.com":                            true,
+	"fiat-500.ga":                             true,
+	"ficken.de":                               true,
+	"fightallspam.com":                        true,
+	"fiifke.de":                               true,
+	"filzmail.com":                            true,
+	"fixmail.tk":                              true,
+	"fizmail.com":                             true,
+	"flashbox.5july.org":                      true,
+	"fleckens.hu":                             true,
+	"flemail.ru":                              true,
+	"flurred.com":                             true,
+	"flyspam.com":                             true,
+	"foodbooto.com":                           true,
+	"footard.com":                             true,
+	"forgetmail.com":                          true,
+	"fornow.eu":                               true,
+	"forward.cat":                             true,
+	"fr33mail.info":                           true,
+	"fragolina2.tk":                           true,
+	"frapmail.com":                            true,
+	"frappina.tk":                             true,
+	"frappina99.tk":                           true,
+	"free-email.cf":                           true,
+	"free-email.ga":                           true,
+	"freelance-france.eu":                     true,
+	"freemail.ms":                             true,
+	"freemail.tweakly.net":                    true,
+	"freemails.cf":                            true,
+	"freemails.ga":                            true,
+	"freemails.ml":                            true,
+	"freemeil.ga":                             true,
+	"freemeil.gq":                             true,
+	"freemeil.ml":                             true,
+	"freundin.ru":                             true,
+	"friendlymail.co.uk":                      true,
+	"front14.org":                             true,
+	"fuckingduh.com":                          true,
+	"fudgerub.com":                            true,
+	"fulvie.com":                              true,
+	"fun64.com":                               true,
+	"fuwamofu.com":                            true,
+	"fux0ringduh.com":                         true,
+	"fw.moza.pl":                              true,
+	"g.hmail.us":                              true,
+	"gamno.config.work":                       true,
+	"garliclife.com":                          true,
+	"gawab.com":                               true,
+	"gelitik.in":                              true,
+	"generator.email":                         true,
+	"get-mail.cf":                             true,
+	"get-mail.ga":                             true,
+	"get-mail.ml":                             true,
+	"get-mail.tk":                             true,
+	"get.pp.ua":                               true,
+	"get1mail.com":                            true,
+	"get2mail.fr":                             true,
+	"getairmail.cf":                           true,
+	"getairmail.com":                          true,
+	"getairmail.ga":                           true,
+	"getairmail.gq":                           true,
+	"getairmail.ml":                           true,
+	"getairmail.tk":                           true,
+	"getmails.eu":                             true,
+	"getnada.com":                             true,
+	"getonemail.com":                          true,
+	"getonemail.net":                          true,
+	"ghosttexter.de":                          true,
+	"girlsundertheinfluence.com":              true,
+	"gishpuppy.com":                           true,
+	"glubex.com":                              true,
+	"go.irc.so":                               true,
+	"go2usa.info":                             true,
+	"godut.com":                               true,
+	"goemailgo.com":                           true,
+	"goooogle.flu.cc":                         true,
+	"goooogle.igg.biz":                        true,
+	"goooogle.nut.cc":                         true,
+	"goooogle.usa.cc":                         true,
+	"gorillaswithdirtyarmpits.com":            true,
+	"gotmail.com":                             true,
+	"gotmail.net":                             true,
+	"gotmail.org":                             true,
+	"gotti.otherinbox.com":                    true,
+	"gowikibooks.com":                         true,
+	"gowikicampus.com":                        true,
+	"gowikicars.com":                          true,
+	"gowikifilms.com":                         true,
+	"gowikigames.com":                         true,
+	"gowikimusic.com":                         true,
+	"gowikinetwork.com":                       true,
+	"gowikitravel.com":                        true,
+	"gowikitv.com":                            true,
+	"grandmamail.com":                         true,
+	"grandmasmail.com":                        true,
+	"great-host.in":                           true,
+	"greensloth.com":                          true,
+	"grr.la":                                  true,
+	"gsrv.co.uk":                              true,
+	"guerillamail.biz":                        true,
+	"guerillamail.com":                        true,
+	"guerillamail.net":                        true,
+	"guerillamail.org":                        true,
+	"guerrillamail.biz":                       true,
+	"guerrillamail.com":                       true,
+	"guerrillamail.de":                        true,
+	"guerrillamail.info":                      true,
+	"guerrillamail.net":                       true,
+	"guerrillamail.org":                       true,
+	"guerrillamailblock.com":                  true,
+	"gustr.com":                               true,
+	"h.mintemail.com":                         true,
+	"h8s.org":                                 true,
+	"hacccc.com":                              true,
+	"haltospam.com":                           true,
+	"harakirimail.com":                        true,
+	"haribu.net":                              true,
+	"hartbot.de":                              true,
+	"hasanmail.ml":                            true,
+	"hatespam.org":                            true,
+	"hellodream.mobi":                         true,
+	"herp.in":                                 true,
+	"hezll.com":                               true,
+	"hidemail.de":                             true,
+	"hidemail.pro":                            true,
+	"hidemail.us":                             true,
+	"hidzz.com":                               true,
+	"hmamail.com":                             true,
+	"hochsitze.com":                           true,
+	"hoer.pw":                                 true,
+	"hopemail.biz":                            true,
+	"horvathurtablahoz.ml":                    true,
+	"hostcalls.com":                           true,
+	"hot-mail.cf":                             true,
+	"hot-mail.ga":                             true,
+	"hot-mail.gq":                             true,
+	"hot-mail.ml":                             true,
+	"hot-mail.tk":                             true,
+	"hotpop.com":                              true,
+	"housat.com":                              true,
+	"hstermail.com":                           true,
+	"hukkmu.tk":                               true,
+	"hulapla.de":                              true,
+	"humn.ws.gy":                              true,
+	"hunrap.usa.cc":                           true,
+	"i.istii.ro":                              true,
+	"i.klipp.su":                              true,
+	"i.wawi.es":                               true,
+	"i.xcode.ro":                              true,
+	"i2pmail.org":                             true,
+	"ichigo.me":                               true,
+	"ieatspam.eu":                             true,
+	"ieatspam.info":                           true,
+	"ieh-mail.de":                             true,
+	"ihateyoualot.info":                       true,
+	"ihazspam.ca":                             true,
+	"iheartspam.org":                          true,
+	"ikbenspamvrij.nl":                        true,
+	"imails.info":                             true,
+	"imgof.com":                               true,
+	"imgv.de":                                 true,
+	"immo-gerance.info":                       true,
+	"imstations.com":                          true,
+	"inbax.tk":                                true,
+	"inbound.plus":                            true,
+	"inbox.si":                                true,
+	"inboxalias.com":                          true,
+	"inboxbear.com":                           true,
+	"inboxclean.com":                          true,
+	"inboxclean.org":                          true,
+	"inboxkitten.com":                         true,
+	"inboxproxy.com":                          true,
+	"inclusiveprogress.com":                   true,
+	"incognitomail.com":                       true,
+	"incognitomail.net":                       true,
+	"incognitomail.org":                       true,
+	"infest.org":                              true,
+	"info-radio.ml":                           true,
+	"inmynetwork.tk":                          true,
+	"insorg-mail.info":                        true,
+	"instant-mail.de":                         true,
+	"instantemailaddress.com":                 true,
+	"instantmail.fr":                          true,
+	"ip4.pp.ua":                               true,
+	"ip6.pp.ua":                               true,
+	"ipoo.org":                                true,
+	"irish2me.com":                            true,
+	"iroid.com":                               true,
+	"isdaq.com":                               true,
+	"italia.flu.cc":                           true,
+	"italia.igg.biz":                          true,
+	"itmtx.com":                               true,
+	"itsme.edu.pl":                            true,
+	"iwi.net":                                 true,
+	"jcpclothing.ga":                          true,
+	"je-recycle.info":                         true,
+	"jet-renovation.fr":                       true,
+	"jetable.com":                             true,
+	"jetable.fr.nf":                           true,
+	"jetable.net":                             true,
+	"jetable.org":                             true,
+	"jetable.pp.ua":                           true,
+	"jnxjn.com":                               true,
+	"jobbikszimpatizans.hu":                   true,
+	"jourrapide.com":                          true,
+	"jp.ftp.sh":                               true,
+	"jsrsolutions.com":                        true,
+	"junk1e.com":                              true,
+	"junkmail.ga":                             true,
+	"junkmail.gq":                             true,
+	"jwk4227ufn.com":                          true,
+	"k.fido.be":                               true,
+	"kachadresp.tk":                           true,
+	"kanker.website":                          true,
+	"kasmail.com":                             true,
+	"kaspop.com":                              true,
+	"kazelink.ml":                             true,
+	"keepmymail.com":                          true,
+	"keinpardon.de":                           true,
+	"kemska.pw":                               true,
+	"killmail.com":                            true,
+	"killmail.net":                            true,
+	"kimsdisk.com":                            true,
+	"kingsq.ga":                               true,
+	"kir.ch.tc":                               true,
+	"klassmaster.com":                         true,
+	"klassmaster.net":                         true,
+	"klzlk.com":                               true,
+	"knol-power.nl":                           true,
+	"kook.ml":                                 true,
+	"koszmail.pl":                             true,
+	"kuatcak.cf":                              true,
+	"kuatcak.tk":                              true,
+	"kuatmail.gq":                             true,
+	"kuatmail.tk":                             true,
+	"kulturbetrieb.info":                      true,
+	"kurzepost.de":                            true,
+	"kusrc.com":                               true,
+	"l33r.eu":                                 true,
+	"labetteraverouge.at":                     true,
+	"lackmail.net":                            true,
+	"lackmail.ru":                             true,
+	"lags.us":                                 true,
+	"lajoska.pe.hu":                           true,
+	"landmail.co":                             true,
+	"laoeq.com":                               true,
+	"laoho.com":                               true,
+	"last-chance.pro":                         true,
+	"lastmail.co":                             true,
+	"lastmail.com":                            true,
+	"lazyinbox.com":                           true,
+	"leeching.net":                            true,
+	"legalrc.loan":                            true,
+	"letthemeatspam.com":                      true,
+	"lhsdv.com":                               true,
+	"lifebyfood.com":                          true,
+	"link2mail.net":                           true,
+	"linkedintuts2016.pw":                     true,
+	"litedrop.com":                            true,
+	"liveradio.tk":                            true,
+	"loadby.us":                               true,
+	"loan101.pro":                             true,
+	"login-email.cf":                          true,
+	"login-email.ga":                          true,
+	"login-email.ml":                          true,
+	"login-email.tk":                          true,
+	"loh.pp.ua":                               true,
+	"lol.ovpn.to":                             true,
+	"lolfreak.net":                            true,
+	"lolito.tk":                               true,
+	"lookugly.com":                            true,
+	"lopl.co.cc":                              true,
+	"lortemail.dk":                            true,
+	"lovefall.ml":                             true,
+	"lovemeleaveme.com":                       true,
+	"lovesea.gq":                              true,
+	"lr7.us":                                  true,
+	"lr78.com":                                true,
+	"lroid.com":                               true,
+	"luv2.us":                                 true,
+	"m.ddcrew.com":                            true,
+	"m4ilweb.info":                            true,
+	"maboard.com":                             true,
+	"macr2.com":                               true,
+	"mail-easy.fr":                            true,
+	"mail-filter.com":                         true,
+	"mail-temporaire.fr":                      true,
+	"mail-tester.com":                         true,
+	"mail.backflip.cf":                        true,
+	"mail.by":                                 true,
+	"mail.mezimages.net":                      true,
+	"mail.tm":                                 true,
+	"mail.wtf":                                true,
+	"mail114.net":                             true,
+	"mail2rss.org":                            true,
+	"mail333.com":                             true,
+	"mail4trash.com":                          true,
+	"mailbidon.com":                           true,
+	"mailblocks.com":                          true,
+	"mailbox72.biz":                           true,
+	"mailbox80.biz":                           true,
+	"mailbucket.org":                          true,
+	"mailcat.biz":                             true,
+	"mailcatch.com":                           true,
+	"maildrop.cc":                             true,
+	"maildrop.cf":                             true,
+	"maildrop.ga":                             true,
+	"maildrop.gq":                             true,
+	"maildrop.ml":                             true,
+	"maildx.com":                              true,
+	"maileater.com":                           true,
+	"mailed.ro":                               true,
+	"maileme101.com":                          true,
+	"mailexpire.com":                          true,
+	"mailfa.tk":                               true,
+	"mailforspam.com":                         true,
+	"mailfree.ga":                             true,
+	"mailfree.gq":                             true,
+	"mailfree.ml":                             true,
+	"mailfreeonline.com":                      true,
+	"mailfs.com":                              true,
+	"mailguard.me":                            true,
+	"mailhero.io":                             true,
+	"mailimate.com":                           true,
+	"mailin8r.com":                            true,
+	"mailinatar.com":                          true,
+	"mailinater.com":                          true,
+	"mailinator.com":                          true,
+	"mailinator.gq":                           true,
+	"mailinator.net":                          true,
+	"mailinator.org":                          true,
+	"mailinator.us":                           true,
+	"mailinator2.com":                         true,
+	"mailincubator.com":                       true,
+	"mailismagic.com":                         true,
+	"mailjunk.cf":                             true,
+	"mailjunk.ga":                             true,
+	"mailjunk.gq":                             true,
+	"mailjunk.ml":                             true,
+	"mailjunk.tk":                             true,
+	"mailmate.com":                            true,
+	"mailme.gq":                               true,
+	"mailme.ir":                               true,
+	"mailme.lv":                               true,
+	"mailme24.com":                            true,
+	"mailmetrash.com":                         true,
+	"mailmoat.com":                            true,
+	"mailnator.com":                           true,
+	"mailnesia.com":                           true,
+	"mailnull.com":                            true,
+	"mailpick.biz":                            true,
+	"mailpoof.com":                            true,
+	"mailproxsy.com":                          true,
+	"mailquack.com":                           true,
+	"mailrock.biz":                            true,
+	"mailsac.com":                             true,
+	"mailscrap.com":                           true,
+	"mailseal.de":                             true,
+	"mailshell.com":                           true,
+	"mailsiphon.com":                          true,
+	"mailslapping.com":                        true,
+	"mailslite.com":                           true,
+	"mailspam.usa.cc":                         true,
+	"mailspam.xyz":                            true,
+	"mailtemp.info":                           true,
+	"mailtome.de":                             true,
+	"mailtothis.com":                          true,
+	"mailzi.ru":                               true,
+	"mailzilla.com":                           true,
+	"mailzilla.org":                           true,
+	"mailzilla.orgmbx.cc":                     true,
+	"makemetheking.com":                       true,
+	"manifestgenerator.com":                   true,
+	"manybrain.com":                           true,
+	"martin.securehost.com.es":                true,
+	"materiali.ml":                            true,
+	"mbx.cc":                                  true,
+	"mciek.com":                               true,
+	"mega.zik.dj":                             true,
+	"meinspamschutz.de":                       true,
+	"meltmail.com":                            true,
+	"merda.flu.cc":                            true,
+	"merda.igg.biz":                           true,
+	"merda.nut.cc":                            true,
+	"merda.usa.cc":                            true,
+	"merry.pink":                              true,
+	"messagebeamer.de":                        true,
+	"mezimages.net":                           true,
+	"mfsa.ru":                                 true,
+	"mierdamail.com":                          true,
+	"migmail.net":                             true,
+	"migmail.pl":                              true,
+	"migumail.com":                            true,
+	"mintemail.com":                           true,
+	"minuteinbox.com":                         true,
+	"mjukglass.nu":                            true,
+	"moakt.com":                               true,
+	"moakt.ws":                                true,
+	"mobi.web.id":                             true,
+	"mobileninja.co.uk":                       true,
+	"moburl.com":                              true,
+	"mohmal.com":                              true,
+	"mohmal.im":                               true,
+	"mohmal.in":                               true,
+	"mohmal.tech":                             true,
+	"moncourrier.fr.nf":                       true,
+	"monemail.fr.nf":                          true,
+	"monmail.fr.nf":                           true,
+	"monumentmail.com":                        true,
+	"mor19.uu.gl":                             true,
+	"morahdsl.cf":                             true,
+	"mox.pp.ua":                               true,
+	"mrblacklist.gq":                          true,
+	"mrresourcepacks.tk":                      true,
+	"ms9.mailslite.com":                       true,
+	"msa.minsmail.com":                        true,
+	"mt2009.com":                              true,
+	"mt2014.com":                              true,
+	"mt2015.com":                              true,
+	"mt2016.com":                              true,
+	"mt2017.com":                              true,
+	"muehlacker.tk":                           true,
+	"muq.orangotango.tk":                      true,
+	"mvrht.com":                               true,
+	"mx0.wwwnew.eu":                           true,
+	"my.efxs.ca":                              true,
+	"my.spam.orangotango.ml":                  true,
+	"my10minutemail.com":                      true,
+	"mycleaninbox.net":                        true,
+	"myemailboxy.com":                         true,
+	"mymail-in.net":                           true,
+	"mymailoasis.com":                         true,
+	"mymailto.cf":                             true,
+	"mymailto.ga":                             true,
+	"myneocards.cz":                           true,
+	"mynetstore.de":                           true,
+	"mypacks.net":                             true,
+	"mypartyclip.de":                          true,
+	"myphantomemail.com":                      true,
+	"myspaceinc.com":                          true,
+	"myspaceinc.net":                          true,
+	"myspaceinc.org":                          true,
+	"myspacepimpedup.com":                     true,
+	"myspamless.com":                          true,
+	"mytemp.email":                            true,
+	"mytempemail.com":                         true,
+	"mytrashmail.com":                         true,
+	"n.ra3.us":                                true,
+	"n.spamtrap.co":                           true,
+	"n.zavio.nl":                              true,
+	"napalm51.cf":                             true,
+	"napalm51.flu.cc":                         true,
+	"napalm51.ga":                             true,
+	"napalm51.gq":                             true,
+	"napalm51.igg.biz":                        true,
+	"napalm51.ml":                             true,
+	"napalm51.nut.cc":                         true,
+	"napalm51.tk":                             true,
+	"napalm51.usa.cc":                         true,
+	"neko2.net":                               true,
+	"neomailbox.com":                          true,
+	"nepwk.com":                               true,
+	"nervmich.net":                            true,
+	"nervtmich.net":                           true,
+	"netmails.com":                            true,
+	"netmails.net":                            true,
+	"netzidiot.de":                            true,
+	"neverbox.com":                            true,
+	"nezzart.com":                             true,
+	"nice-4u.com":                             true,
+	"nike.coms.hk":                            true,
+	"nmail.cf":                                true,
+	"no-spam.ws":                              true,
+	"nobulk.com":                              true,
+	"noclickemail.com":                        true,
+	"nogmailspam.info":                        true,
+	"nomail.xl.cx":                            true,
+	"nomail2me.com":                           true,
+	"nomorespamemails.com":                    true,
+	"nonspam.eu":                              true,
+	"nonspammer.de":                           true,
+	"noref.in":                                true,
+	"nospam.wins.com.br":                      true,
+	"nospam.ze.tc":                            true,
+	"nospam4.us":                              true,
+	"nospamfor.us":                            true,
+	"nospamthanks.info":                       true,
+	"notmailinator.com":                       true,
+	"notsharingmy.info":                       true,
+	"nowhere.org":                             true,
+	"nowmymail.com":                           true,
+	"ntlhelp.net":                             true,
+	"nurfuerspam.de":                          true,
+	"nus.edu.sg":                              true,
+	"nutpa.net":                               true,
+	"nwldx.com":                               true,
+	"nwytg.com":                               true,
+	"o.cfo2go.ro":                             true,
+	"o.oai.asia":                              true,
+	"o.opendns.ro":                            true,
+	"o.spamtrap.ro":                           true,
+	"objectmail.com":                          true,
+	"obobbo.com":                              true,
+	"odaymail.com":                            true,
+	"olypmall.ru":                             true,
+	"one-time.email":                          true,
+	"oneoffemail.com":                         true,
+	"oneoffmail.com":                          true,
+	"onewaymail.com":                          true,
+	"online.ms":                               true,
+	"oopi.org":                                true,
+	"opayq.com":                               true,
+	"opel-corsa.tk":                           true,
+	"opentrash.com":                           true,
+	"orango.cu.cc":                            true,
+	"ordinaryamerican.net":                    true,
+	"oshietechan.link":                        true,
+	"otherinbox.com":                          true,
+	"ourklips.com":                            true,
+	"outlawspam.com":                          true,
+	"ovpn.to":                                 true,
+	"owlpic.com":                              true,
+	"p71ce1m.com":                             true,
+	"pagamenti.tk":                            true,
+	"paller.cf":                               true,
+	"pancakemail.com":                         true,
+	"paplease.com":                            true,
+	"parlimentpetitioner.tk":                  true,
+	"password.colafanta.cf":                   true,
+	"pcusers.otherinbox.com":                  true,
+	"pepbot.com":                              true,
+	"pepsi.coms.hk":                           true,
+	"pfui.ru":                                 true,
+	"photo-impact.eu":                         true,
+	"phpbb.uu.gl":                             true,
+	"phus8kajuspa.cu.cc":                      true,
+	"pimpedupmyspace.com":                     true,
+	"pjjkp.com":                               true,
+	"plexolan.de":                             true,
+	"po.bot.nu":                               true,
+	"poh.pp.ua":                               true,
+	"pokemail.net":                            true,
+	"politikerclub.de":                        true,
+	"polyfaust.com":                           true,
+	"poofy.org":                               true,
+	"pookmail.com":                            true,
+	"porco.cf":                                true,
+	"porco.ga":                                true,
+	"porco.gq":                                true,
+	"porco.ml":                                true,
+	"postacin.com":                            true,
+	"ppetw.com":                               true,
+	"premium-mail.fr":                         true,
+	"privacy.net":                             true,
+	"privy-mail.com":                          true,
+	"privymail.de":                            true,
+	"project-xhabbo.com":                      true,
+	"proxymail.eu":                            true,
+	"prtnx.com":                               true,
+	"prtz.eu":                                 true,
+	"psles.com":                               true,
+	"punkass.com":                             true,
+	"purple.flu.cc":                           true,
+	"purple.igg.biz":                          true,
+	"purple.nut.cc":                           true,
+	"purple.usa.cc":                           true,
+	"puttanamaiala.tk":                        true,
+	"putthisinyourspamdatabase.com":           true,
+	"pw.flu.cc":                               true,
+	"pw.igg.biz":                              true,
+	"pw.nut.cc":                               true,
+	"pwrby.com":                               true,
+	"q5vm7pi9.com":                            true,
+	"qasti.com":                               true,
+	"qisdo.com":                               true,
+	"qisoa.com":                               true,
+	"qs.dp76.com":                             true,
+	"quickinbox.com":                          true,
+	"quickmail.nl":                            true,
+	"r8.porco.cf":                             true,
+	"radiku.ye.vc":                            true,
+	"rajeshcon.cf":                            true,
+	"rcpt.at":                                 true,
+	"re-gister.com":                           true,
+	"reality-concept.club":                    true,
+	"reallymymail.com":                        true,
+	"receiveee.chickenkiller.com":             true,
+	"receiveee.com":                           true,
+	"recode.me":                               true,
+	"reconmail.com":                           true,
+	"recursor.net":                            true,
+	"recyclemail.dk":                          true,
+	"reddit.usa.cc":                           true,
+	"regbypass.com":                           true,
+	"regbypass.comsafe-mail.net":              true,
+	"regspaces.tk":                            true,
+	"rejectmail.com":                          true,
+	"remail.cf":                               true,
+	"remail.ga":                               true,
+	"renault-clio.cf":                         true,
+	"resgedvgfed.tk":                          true,
+	"retkesbusz.nut.cc":                       true,
+	"rhyta.com":                               true,
+	"rk9.chickenkiller.com":                   true,
+	"rklips.com":                              true,
+	"rkomo.com":                               true,
+	"rmqkr.net":                               true,
+	"rootfest.net":                            true,
+	"royal.net":                               true,
+	"rppkn.com":                               true,
+	"rtrtr.com":                               true,
+	"rudymail.ml":                             true,
+	"ruffrey.com":                             true,
+	"ruru.be":                                 true,
+	"rx.dred.ru":                              true,
+	"rx.qc.to":                                true,
+	"s.bloq.ro":                               true,
+	"s.dextm.ro":                              true,
+	"s.proprietativalcea.ro":                  true,
+	"s.sa.igg.biz":                            true,
+	"s.spamserver.flu.cc":                     true,
+	"s.vdig.com":                              true,
+	"s00.orangotango.ga":                      true,
+	"s0ny.net":                                true,
+	"sa.igg.biz":                              true,
+	"safersignup.de":                          true,
+	"safetymail.info":                         true,
+	"safetypost.de":                           true,
+	"sandelf.de":                              true,
+	"savelife.ml":                             true,
+	"saynotospams.com":                        true,
+	"scatmail.com":                            true,
+	"schafmail.de":                            true,
+	"secure-mail.biz":                         true,
+	"secure-mail.cc":                          true,
+	"securehost.com.es":                       true,
+	"selfdestructingmail.com":                 true,
+	"selfdestructingmail.org":                 true,
+	"sendspamhere.com":                        true,
+	"servermaps.net":                          true,
+	"sfmail.top":                              true,
+	"sharedmailbox.org":                       true,
+	"sharklasers.com":                         true,
+	"shieldedmail.com":                        true,
+	"shiftmail.com":                           true,
+	"shitaway.cf":                             true,
+	"shitaway.cu.cc":                          true,
+	"shitaway.flu.cc":                         true,
+	"shitaway.ga":                             true,
+	"shitaway.gq":                             true,
+	"shitaway.igg.biz":                        true,
+	"shitaway.ml":                             true,
+	"shitaway.nut.cc":                         true,
+	"shitaway.tk":                             true,
+	"shitaway.usa.cc":                         true,
+	"shitmail.de":                             true,
+	"shitmail.me":                             true,
+	"shitmail.org":                            true,
+	"shitware.nl":                             true,
+	"shockinmytown.cu.cc":                     true,
+	"shortmail.net":                           true,
+	"shotmail.ru":                             true,
+	"showslow.de":                             true,
+	"shuffle.email":                           true,
+	"siliwangi.ga":                            true,
+	"sinnlos-mail.de":                         true,
+	"siteposter.net":                          true,
+	"skeefmail.com":                           true,
+	"skrx.tk":                                 true,
+	"sky-mail.ga":                             true,
+	"slaskpost.se":                            true,
+	"slave-auctions.net":                      true,
+	"slippery.email":                          true,
+	"slipry.net":                              true,
+	"slopsbox.com":                            true,
+	"slushmail.com":                           true,
+	"smap.4nmv.ru":                            true,
+	"smashmail.de":                            true,
+	"smellfear.com":                           true,
+	"smellrear.com":                           true,
+	"snakemail.com":                           true,
+	"sneakemail.com":                          true,
+	"snkmail.com":                             true,
+	"social-mailer.tk":                        true,
+	"sofimail.com":                            true,
+	"sofort-mail.de":                          true,
+	"softpls.asia":                            true,
+	"sogetthis.com":                           true,
+	"sohu.com":                                true,
+	"soisz.com":                               true,
+	"solar-impact.pro":                        true,
+	"solvemail.info":                          true,
+	"soodomail.com":                           true,
+	"soodonims.com":                           true,
+	"spam-a.porco.cf":                         true,
+	"spam-b.porco.cf":                         true,
+	"spam-be-gone.com":                        true,
+	"spam.2012-2016.ru":                       true,
+	"spam.flu.cc":                             true,
+	"spam.igg.biz":                            true,
+	"spam.la":                                 true,
+	"spam.nut.cc":                             true,
+	"spam.orangotango.ml":                     true,
+	"spam.su":                                 true,
+	"spam.usa.cc":                             true,
+	"spam4.me":                                true,
+	"spamavert.com":                           true,
+	"spambob.com":                             true,
+	"spambob.net":                             true,
+	"spambob.org":                             true,
+	"spambog.com":                             true,
+	"spambog.de":                              true,
+	"spambog.net":                             true,
+	"spambog.ru":                              true,
+	"spambooger.com":                          true,
+	"spambox.info":                            true,
+	"spambox.irishspringrealty.com":           true,
+	"spambox.us":                              true,
+	"spamcannon.com":                          true,
+	"spamcannon.net":                          true,
+	"spamcero.com":                            true,
+	"spamcon.org":                             true,
+	"spamcorptastic.com":                      true,
+	"spamcowboy.com":                          true,
+	"spamcowboy.net":                          true,
+	"spamcowboy.org":                          true,
+	"spamday.com":                             true,
+	"spamdecoy.net":                           true,
+	"spamex.com":                              true,
+	"spamfighter.cf":                          true,
+	"spamfighter.ga":                          true,
+	"spamfighter.gq":                          true,
+	"spamfighter.ml":                          true,
+	"spamfighter.tk":                          true,
+	"spamfree.eu":                             true,
+	"spamfree24.com":                          true,
+	"spamfree24.de":                           true,
+	"spamfree24.eu":                           true,
+	"spamfree24.info":                         true,
+	"spamfree24.net":                          true,
+	"spamfree24.org":                          true,
+	"spamgoes.in":                             true,
+	"spamgourmet.com":                         true,
+	"spamgourmet.net":                         true,
+	"spamgourmet.org":                         true,
+	"spamherelots.com":                        true,
+	"spamhereplease.com":                      true,
+	"spamhole.com":                            true,
+	"spamify.com":                             true,
+	"spaminator.de":                           true,
+	"spamkill.info":                           true,
+	"spaml.com":                               true,
+	"spaml.de":                                true,
+	"spammotel.com":                           true,
+	"spamobox.com":                            true,
+	"spamoff.de":                              true,
+	"spamsalad.in":                            true,
+	"spamserver.cf":                           true,
+	"spamserver.flu.cc":                       true,
+	"spamserver.ml":                           true,
+	"spamserver.tk":                           true,
+	"spamslicer.com":                          true,
+	"spamspot.com":                            true,
+	"spamstack.net":                           true,
+	"spamthis.co.uk":                          true,
+	"spamthisplease.com":                      true,
+	"spamtrail.com":                           true,
+	"spamtroll.net":                           true,
+	"speed.1s.fr":                             true,
+	"sperma.cf":                               true,
+	"spikio.com":                              true,
+	"spoofmail.de":                            true,
+	"spybox.de":                               true,
+	"squizzy.de":                              true,
+	"squizzy.net":                             true,
+	"sr.ro.lt":                                true,
+	"sraka.xyz":                               true,
+	"sroff.com":                               true,
+	"ss.undo.it":                              true,
+	"ssoia.com":                               true,
+	"startkeys.com":                           true,
+	"stexsy.com":                              true,
+	"stinkefinger.net":                        true,
+	"stop-my-spam.cf":                         true,
+	"stop-my-spam.com":                        true,
+	"stop-my-spam.ga":                         true,
+	"stop-my-spam.ml":                         true,
+	"stop-my-spam.pp.ua":                      true,
+	"stop-my-spam.tk":                         true,
+	"streetwisemail.com":                      true,
+	"stromox.com":                             true,
+	"stuffmail.de":                            true,
+	"sudolife.me":                             true,
+	"sudolife.net":                            true,
+	"sudomail.biz":                            true,
+	"sudomail.com":                            true,
+	"sudomail.net":                            true,
+	"sudoverse.com":                           true,
+	"sudoverse.net":                           true,
+	"sudoweb.net":                             true,
+	"sudoworld.com":                           true,
+	"sudoworld.net":                           true,
+	"supergreatmail.com":                      true,
+	"supermailer.jp":                          true,
+	"superrito.com":                           true,
+	"superstachel.de":                         true,
+	"suremail.info":                           true,
+	"susi.ml":                                 true,
+	"svk.jp":                                  true,
+	"sweetxxx.de":                             true,
+	"szerz.com":                               true,
+	"t.psh.me":                                true,
+	"tafmail.com":                             true,
+	"taglead.com":                             true,
+	"tagyourself.com":                         true,
+	"talkinator.com":                          true,
+	"tapchicuoihoi.com":                       true,
+	"tarzan.usa.cc":                           true,
+	"tarzanmail.cf":                           true,
+	"tarzanmail.ml":                           true,
+	"teamspeak3.ga":                           true,
+	"teewars.org":                             true,
+	"teleosaurs.xyz":                          true,
+	"teleworm.com":                            true,
+	"teleworm.us":                             true,
+	"temp-mail.com":                           true,
+	"temp-mail.de":                            true,
+	"temp-mail.io":                            true,
+	"temp-mail.org":                           true,
+	"temp.bartdevos.be":                       true,
+	"temp.emeraldwebmail.com":                 true,
+	"temp.headstrong.de":                      true,
+	"temp.mail.y59.jp":                        true,
+	"tempail.com":                             true,
+	"tempalias.com":                           true,
+	"tempe-mail.com":                          true,
+	"tempemail.biz":                           true,
+	"tempemail.co.za":                         true,
+	"tempemail.com":                           true,
+	"tempemail.net":                           true,
+	"tempinbox.co.uk":                         true,
+	"tempinbox.com":                           true,
+	"tempmail.co":                             true,
+	"tempmail.it":                             true,
+	"tempmail.pro":                            true,
+	"tempmail.us":                             true,
+	"tempmail2.com":                           true,
+	"tempmaildemo.com":                        true,
+	"tempmailer.com":                          true,
+	"tempmailo.com":                           true,
+	"tempomail.fr":                            true,
+	"temporarily.de":                          true,
+	"temporarioemail.com.br":                  true,
+	"temporaryemail.net":                      true,
+	"temporaryemail.us":                       true,
+	"temporaryforwarding.com":                 true,
+	"temporaryinbox.com":                      true,
+	"tempr.email":                             true,
+	"tempsky.com":                             true,
+	"tempthe.net":                             true,
+	"tempymail.com":                           true,
+	"thanksnospam.info":                       true,
+	"thankyou2010.com":                        true,
+	"thecloudindex.com":                       true,
+	"thereddoors.online":                      true,
+	"thisisnotmyrealemail.com":                true,
+	"thraml.com":                              true,
+	"thrma.com":                               true,
+	"throam.com":                              true,
+	"thrott.com":                              true,
+	"throwam.com":                             true,
+	"throwawayemailaddress.com":               true,
+	"throwawaymail.com":                       true,
+	"throya.com":                              true,
+	"tilien.com":                              true,
+	"tittbit.in":                              true,
+	"tm.tosunkaya.com":                        true,
+	"tmail.ws":                                true,
+	"tmailinator.com":                         true,
+	"tmpmail.net":                             true,
+	"tmpmail.org":                             true,
+	"toiea.com":                               true,
+	"toomail.biz":                             true,
+	"top9appz.info":                           true,
+	"tradermail.info":                         true,
+	"tralalajos.ga":                           true,
+	"tralalajos.gq":                           true,
+	"tralalajos.ml":                           true,
+	"tralalajos.tk":                           true,
+	"trash-amil.com":                          true,
+	"trash-mail.at":                           true,
+	"trash-mail.cf":                           true,
+	"trash-mail.com":                          true,
+	"trash-mail.de":                           true,
+	"trash-mail.ga":                           true,
+	"trash-mail.gq":                           true,
+	"trash-mail.ml":                           true,
+	"trash-mail.tk":                           true,
+	"trash-me.com":                            true,
+	"trash2009.com":                           true,
+	"trash2010.com":                           true,
+	"trash2011.com":                           true,
+	"trashcanmail.com":                        true,
+	"trashdevil.com":                          true,
+	"trashdevil.de":                           true,
+	"trashemail.de":                           true,
+	"trashmail.at":                            true,
+	"trashmail.com":                           true,
+	"trashmail.de":                            true,
+	"trashmail.me":                            true,
+	"trashmail.net":                           true,
+	"trashmail.org":                           true,
+	"trashmail.ws":                            true,
+	"trashmailer.com":                         true,
+	"trashymail.com":                          true,
+	"trashymail.net":                          true,
+	"trayna.com":                              true,
+	"trbvm.com":                               true,
+	"trbvn.com":                               true,
+	"trbvo.com":                               true,
+	"trickmail.net":                           true,
+	"trillianpro.com":                         true,
+	"trump.flu.cc":                            true,
+	"trump.igg.biz":                           true,
+	"tryalert.com":                            true,
+	"turoid.com":                              true,
+	"turual.com":                              true,
+	"tvchd.com":                               true,
+	"tverya.com":                              true,
+	"twinmail.de":                             true,
+	"twoweirdtricks.com":                      true,
+	"ty.ceed.se":                              true,
+	"tyldd.com":                               true,
+	"u.0u.ro":                                 true,
+	"u.10x.es":                                true,
+	"u.2sea.org":                              true,
+	"u.900k.es":                               true,
+	"u.civvic.ro":                             true,
+	"u.dmarc.ro":                              true,
+	"u.labo.ch":                               true,
+	"u14269.ml":                               true,
+	"uacro.com":                               true,
+	"ubismail.net":                            true,
+	"ucupdong.ml":                             true,
+	"uggsrock.com":                            true,
+	"uk.flu.cc":                               true,
+	"uk.igg.biz":                              true,
+	"uk.nut.cc":                               true,
+	"umail.net":                               true,
+	"unmail.ru":                               true,
+	"upliftnow.com":                           true,
+	"uplipht.com":                             true,
+	"urfey.com":                               true,
+	"uroid.com":                               true,
+	"used-product.fr":                         true,
+	"username.e4ward.com":                     true,
+	"ux.dob.jp":                               true,
+	"ux.uk.to":                                true,
+	"v.0v.ro":                                 true,
+	"v.jsonp.ro":                              true,
+	"vaasfc4.tk":                              true,
+	"valemail.net":                            true,
+	"venompen.com":                            true,
+	"veryrealemail.com":                       true,
+	"vfemail.net":                             true,
+	"vickaentb.tk":                            true,
+	"vidchart.com":                            true,
+	"viditag.com":                             true,
+	"viewcastmedia.com":                       true,
+	"viewcastmedia.net":                       true,
+	"viewcastmedia.org":                       true,
+	"viroleni.cu.cc":                          true,
+	"visa.coms.hk":                            true,
+	"vkcode.ru":                               true,
+	"vomoto.com":                              true,
+	"vp.ycare.de":                             true,
+	"vps30.com":                               true,
+	"vssms.com":                               true,
+	"vubby.com":                               true,
+	"vw-golf.gq":                              true,
+	"vzlom4ik.tk":                             true,
+	"w.0w.ro":                                 true,
+	"walala.org":                              true,
+	"walkmail.net":                            true,
+	"walkmail.ru":                             true,
+	"wasd.dropmail.me":                        true,
+	"wazabi.club":                             true,
+	"we.qq.my":                                true,
+	"web-contact.info":                        true,
+	"web-emailbox.eu":                         true,
+	"web-ideal.fr":                            true,
+	"web-mail.pp.ua":                          true,
+	"web.discard-email.cf":                    true,
+	"webcontact-france.eu":                    true,
+	"webemail.me":                             true,
+	"webm4il.info":                            true,
+	"webuser.in":                              true,
+	"wee.my":                                  true,
+	"wefjo.grn.cc":                            true,
+	"weg-werf-email.de":                       true,
+	"wegwerf-email-addressen.de":              true,
+	"wegwerf-emails.de":                       true,
+	"wegwerfadresse.de":                       true,
+	"wegwerfemail.de":                         true,
+	"wegwerfmail.de":                          true,
+	"wegwerfmail.info":                        true,
+	"wegwerfmail.net":                         true,
+	"wegwerfmail.org":                         true,
+	"wegwerpmailadres.nl":                     true,
+	"wetrainbayarea.com":                      true,
+	"wetrainbayarea.org":                      true,
+	"wfgdfhj.tk":                              true,
+	"wh4f.org":                                true,
+	"whatiaas.com":                            true,
+	"whatpaas.com":                            true,
+	"whatsaas.com":                            true,
+	"whopy.com":                               true,
+	"whtjddn.33mail.com":                      true,
+	"whyspam.me":                              true,
+	"wickmail.net":                            true,
+	"wilemail.com":                            true,
+	"willselfdestruct.com":                    true,
+	"winemaven.info":                          true,
+	"wiz2.site":                               true,
+	"wmail.cf":                                true,
+	"wollan.info":                             true,
+	"worldspace.link":                         true,
+	"wovz.cu.cc":                              true,
+	"wr.moeri.org":                            true,
+	"wronghead.com":                           true,
+	"wt2.orangotango.cf":                      true,
+	"wuzup.net":                               true,
+	"wuzupmail.net":                           true,
+	"www.bccto.me":                            true,
+	"www.e4ward.com":                          true,
+	"www.gishpuppy.com":                       true,
+	"www.mailinator.com":                      true,
+	"wwwnew.eu":                               true,
+	"xagloo.com":                              true,
+	"xemaps.com":                              true,
+	"xents.com":                               true,
+	"xing886.uu.gl":                           true,
+	"xmaily.com":                              true,
+	"xoxox.cc":                                true,
+	"xoxy.net":                                true,
+	"xww.ro":                                  true,
+	"xy9ce.tk":                                true,
+	"xyzfree.net":                             true,
+	"xzsok.com":                               true,
+	"yandere.cu.cc":                           true,
+	"yapped.net":                              true,
+	"yeah.net":                                true,
+	"yellow.flu.cc":                           true,
+	"yellow.hotakama.tk":                      true,
+	"yellow.igg.biz":                          true,
+	"yep.it":                                  true,
+	"yert.ye.vc":                              true,
+	"yogamaven.com":                           true,
+	"yomail.info":                             true,
+	"yopmail.com":                             true,
+	"yopmail.fr":                              true,
+	"yopmail.gq":                              true,
+	"yopmail.net":                             true,
+	"yopmail.pp.ua":                           true,
+	"yordanmail.cf":                           true,
+	"you-spam.com":                            true,
+	"youmail.ga":                              true,
+	"yourlifesucks.cu.cc":                     true,
+	"ypmail.webarnak.fr.eu.org":               true,
+	"yroid.com":                               true,
+	"yuurok.com":                              true,
+	"z1p.biz":                                 true,
+	"za.com":                                  true,
+	"zain.site":                               true,
+	"zainmax.net":                             true,
+	"zaktouni.fr":                             true,
+	"ze.gally.jp":                             true,
+	"zehnminutenmail.de":                      true,
+	"zeta-telecom.com":                        true,
+	"zetmail.com":                             true,
+	"zhcne.com":                               true,
+	"zhouemail.510520.org":                    true,
+	"zippymail.info":                          true,
+	"zoaxe.com":                               true,
+	"zoemail.com":                             true,
+	"zoemail.net":                             true,
+	"zoemail.org":                             true,
+	"zombo.flu.cc":                            true,
+	"zombo.igg.biz":                           true,
+	"zombo.nut.cc":                            true,
+	"zomg.info":                               true,
+	"zxcv.com":                                true,
+	"zxcvbnm.com":                             true,
+	"zzz.com":                                 true,
 }
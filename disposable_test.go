@@ -0,0 +1,123 @@
+package mailck
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewDisposableList(t *testing.T) {
+	l := NewDisposableList("Throwaway.Example.", "bücher-wegwerf.example")
+
+	assert.True(t, l.Contains("mailinator.com"))
+	assert.True(t, l.Contains("throwaway.example"))
+	assert.True(t, l.CheckDisposable("foo@THROWAWAY.example"))
+	assert.True(t, l.CheckDisposable("foo@bücher-wegwerf.example"))
+	assert.True(t, l.Contains("xn--bcher-wegwerf-wob.example"))
+	assert.False(t, l.CheckDisposable("sebastian@mancke.net"))
+	assert.Equal(t, len(DisposableDomains)+2, l.Len())
+	assert.False(t, CheckDisposable("foo@throwaway.example"), "the embedded list is unchanged")
+}
+
+func TestLoadDisposableList(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "a.txt"), "# comment\n\nfirst.example\n  second.example  \n")
+	writeFile(t, filepath.Join(dir, "b.txt"), "third.example\n")
+	writeFile(t, filepath.Join(dir, ".hidden"), "hidden.example\n")
+	file := filepath.Join(t.TempDir(), "single.txt")
+	writeFile(t, file, "fourth.example\n")
+
+	l, err := LoadDisposableList(dir, file)
+	assert.NoError(t, err)
+
+	for _, domain := range []string{"mailinator.com", "first.example", "second.example", "third.example", "fourth.example"} {
+		assert.True(t, l.Contains(domain), domain)
+	}
+	assert.False(t, l.Contains("hidden.example"))
+	assert.False(t, l.Contains("# comment"))
+	assert.False(t, l.Modified())
+}
+
+func TestLoadDisposableList_Error(t *testing.T) {
+	_, err := LoadDisposableList(filepath.Join(t.TempDir(), "missing.txt"))
+	assert.Error(t, err)
+}
+
+func TestDisposableList_Reload(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "list.txt")
+	writeFile(t, file, "first.example\n")
+
+	l, err := LoadDisposableList(dir)
+	assert.NoError(t, err)
+	assert.True(t, l.Contains("first.example"))
+
+	writeFile(t, file, "second.example\n")
+	future := time.Now().Add(time.Minute)
+	assert.NoError(t, os.Chtimes(file, future, future))
+	assert.True(t, l.Modified())
+
+	assert.NoError(t, l.Reload())
+	assert.False(t, l.Modified())
+	assert.False(t, l.Contains("first.example"))
+	assert.True(t, l.Contains("second.example"))
+
+	// a new file in the directory
+	writeFile(t, filepath.Join(dir, "new.txt"), "third.example\n")
+	assert.True(t, l.Modified())
+	assert.NoError(t, l.Reload())
+	assert.True(t, l.Contains("third.example"))
+
+	// a failed reload keeps the list
+	assert.NoError(t, os.RemoveAll(dir))
+	assert.True(t, l.Modified())
+	assert.Error(t, l.Reload())
+	assert.True(t, l.Contains("second.example"))
+}
+
+func TestDisposableList_ConcurrentReload(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "list.txt")
+	writeFile(t, file, "first.example\n")
+	l, err := LoadDisposableList(file)
+	assert.NoError(t, err)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				assert.True(t, l.CheckDisposable("foo@first.example"))
+				assert.True(t, l.CheckDisposable("foo@mailinator.com"))
+			}
+		}()
+	}
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, l.Reload())
+	}
+	wg.Wait()
+}
+
+func TestChecker_WithDisposableList(t *testing.T) {
+	checker := NewChecker(WithChecks(SyntaxCheck|DisposableCheck), WithDisposableList(NewDisposableList("throwaway.example")))
+
+	result, err := checker.Check("foo@throwaway.example")
+	assert.NoError(t, err)
+	assert.True(t, result.Is(Disposable))
+
+	result, err = checker.Check("foo@mailinator.com")
+	assert.NoError(t, err)
+	assert.True(t, result.Is(Disposable))
+
+	result, err = checker.Check("foo@example.com")
+	assert.NoError(t, err)
+	assert.True(t, result.Is(SyntaxChecked))
+}
+
+func writeFile(t *testing.T, name, content string) {
+	t.Helper()
+	assert.NoError(t, os.WriteFile(name, []byte(content), 0644))
+}
@@ -0,0 +1,108 @@
+package mailck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/textproto"
+)
+
+// ErrTimeout is matched by errors.Is for all errors of a check, which were caused by a timeout.
+var ErrTimeout = errors.New("timeout")
+
+// SMTPError is returned, if the conversation with a mailserver failed.
+// It either contains the negative reply of the mailserver or, if Code is zero,
+// the network error of the conversation in Err.
+type SMTPError struct {
+	// Host is the MX host.
+	Host string
+	// Stage is the phase of the conversation, in which the error occurred.
+	Stage Phase
+	// Reply is the reply of the mailserver. It is the zero value, if no reply was received.
+	Reply
+	// Err is the underlying error, e.g. a *textproto.Error.
+	Err error
+}
+
+// newSMTPError wraps err, which occurred in the given stage of the conversation with host.
+func newSMTPError(host string, stage Phase, err error) *SMTPError {
+	e := &SMTPError{Host: host, Stage: stage, Err: err}
+	var protoErr *textproto.Error
+	if errors.As(err, &protoErr) {
+		e.Reply = ParseReply(protoErr.Code, protoErr.Msg)
+	}
+	return e
+}
+
+func (e *SMTPError) Error() string {
+	prefix := e.Host
+	if e.Stage != "" {
+		prefix += " " + string(e.Stage)
+	}
+	if e.Code == 0 {
+		return fmt.Sprintf("smtp error at %v: %v", prefix, e.Err)
+	}
+	return fmt.Sprintf("smtp error at %v: %v", prefix, e.Reply)
+}
+
+func (e *SMTPError) Unwrap() error {
+	return e.Err
+}
+
+// Is matches ErrTimeout, if the conversation timed out.
+func (e *SMTPError) Is(target error) bool {
+	return target == ErrTimeout && isTimeout(e.Err)
+}
+
+// DNSError is returned, if the mailservers of a domain could not be looked up.
+type DNSError struct {
+	// Domain is the domain of the checked address.
+	Domain string
+	// Err is the error of the resolver, usually a *net.DNSError.
+	Err error
+}
+
+func (e *DNSError) Error() string {
+	return fmt.Sprintf("dns lookup of %v failed: %v", e.Domain, e.Err)
+}
+
+func (e *DNSError) Unwrap() error {
+	return e.Err
+}
+
+// Is matches ErrTimeout, if the lookup timed out.
+func (e *DNSError) Is(target error) bool {
+	return target == ErrTimeout && isTimeout(e.Err)
+}
+
+// DialError is returned, if no connection to a mailserver could be established.
+type DialError struct {
+	// Host is the MX host.
+	Host string
+	// Addr is the dialed address including the port.
+	Addr string
+	// Err is the error of the dialer, usually a *net.OpError.
+	Err error
+}
+
+func (e *DialError) Error() string {
+	return fmt.Sprintf("connect to %v failed: %v", e.Addr, e.Err)
+}
+
+func (e *DialError) Unwrap() error {
+	return e.Err
+}
+
+// Is matches ErrTimeout, if the connect timed out.
+func (e *DialError) Is(target error) bool {
+	return target == ErrTimeout && isTimeout(e.Err)
+}
+
+func isTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,100 @@
+package mailck
+
+import (
+	"context"
+	"errors"
+	"github.com/siebenmann/smtpd"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net"
+	"net/textproto"
+	"testing"
+	"time"
+)
+
+func Test_newSMTPError(t *testing.T) {
+	protoErr := &textproto.Error{Code: 550, Msg: "5.1.1 User unknown"}
+	smtpErr := newSMTPError("mx.example.com", PhaseRcptTo, protoErr)
+	assert.Equal(t, 550, smtpErr.Code)
+	assert.Equal(t, EnhancedCode{5, 1, 1}, smtpErr.Enhanced)
+	assert.Equal(t, "User unknown", smtpErr.Text)
+	assert.Equal(t, PhaseRcptTo, smtpErr.Stage)
+	assert.Equal(t, "smtp error at mx.example.com rcptTo: 550 5.1.1 User unknown", smtpErr.Error())
+	assert.True(t, errors.Is(smtpErr, protoErr))
+	assert.False(t, errors.Is(smtpErr, ErrTimeout))
+
+	smtpErr = newSMTPError("mx.example.com", PhaseGreeting, io.EOF)
+	assert.Equal(t, 0, smtpErr.Code)
+	assert.Equal(t, "smtp error at mx.example.com greeting: EOF", smtpErr.Error())
+	assert.True(t, errors.Is(smtpErr, io.EOF))
+}
+
+func Test_ErrTimeout(t *testing.T) {
+	timeoutErr := &net.DNSError{Err: "i/o timeout", IsTimeout: true}
+	tests := []struct {
+		err     error
+		timeout bool
+	}{
+		{newSMTPError("mx.example.com", "", context.DeadlineExceeded), true},
+		{newSMTPError("mx.example.com", PhaseRcptTo, &net.OpError{Op: "read", Err: timeoutErr}), true},
+		{newSMTPError("mx.example.com", PhaseRcptTo, io.EOF), false},
+		{&DNSError{Domain: "example.com", Err: timeoutErr}, true},
+		{&DNSError{Domain: "example.com", Err: &net.DNSError{Err: "server misbehaving"}}, false},
+		{&DialError{Host: "mx.example.com", Addr: "mx.example.com:25", Err: &net.OpError{Op: "dial", Err: timeoutErr}}, true},
+		{&DialError{Host: "mx.example.com", Addr: "mx.example.com:25", Err: errors.New("connection refused")}, false},
+		{&TemporaryError{Code: 451, Err: newSMTPError("mx.example.com", PhaseRcptTo, &textproto.Error{Code: 451})}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.err.Error(), func(t *testing.T) {
+			assert.Equal(t, test.timeout, errors.Is(test.err, ErrTimeout))
+		})
+	}
+}
+
+func Test_checkMailbox_TypedErrors(t *testing.T) {
+	dummyServer := NewDummySMTPServer("localhost:2525", smtpd.MAILFROM, false, 0)
+	defer dummyServer.Close()
+
+	result, err := testChecker(2525).checkMailbox(context.Background(), "foo@bar.de", []*net.MX{{Host: "localhost"}}, newRecorder("foo@bar.de"))
+	assert.Equal(t, MailserverError, result)
+	var smtpErr *SMTPError
+	if assert.True(t, errors.As(err, &smtpErr)) {
+		assert.Equal(t, "localhost", smtpErr.Host)
+		assert.Equal(t, PhaseMailFrom, smtpErr.Stage)
+		assert.Equal(t, 550, smtpErr.Code)
+	}
+
+	result, err = testChecker(6666).checkMailbox(context.Background(), "foo@bar.de", []*net.MX{{Host: "localhost"}}, newRecorder("foo@bar.de"))
+	assert.Equal(t, NetworkError, result)
+	var dialErr *DialError
+	if assert.True(t, errors.As(err, &dialErr)) {
+		assert.Equal(t, "localhost", dialErr.Host)
+		assert.Equal(t, "127.0.0.1:6666", dialErr.Addr)
+	}
+	assert.False(t, errors.Is(err, ErrTimeout))
+}
+
+func Test_checkMailbox_TypedErrors_Timeout(t *testing.T) {
+	dummyServer := NewDummySMTPServer("localhost:2525", smtpd.QUIT, false, 500*time.Millisecond)
+	defer dummyServer.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	result, err := testChecker(2525).checkMailbox(ctx, "foo@bar.de", []*net.MX{{Host: "127.0.0.1"}}, newRecorder("foo@bar.de"))
+	assert.True(t, result.Is(TimeoutError))
+	assert.True(t, errors.Is(err, ErrTimeout))
+}
+
+func TestChecker_DNSTimeout(t *testing.T) {
+	r := NewStaticResolver().Timeout("slow.example.com")
+	c := NewChecker(WithResolver(r), WithDialer(failingDialer{t}))
+
+	report, err := c.CheckWithReport(context.Background(), "foo@slow.example.com")
+	assert.True(t, report.Is(TimeoutError))
+	assert.Equal(t, PhaseLookup, report.TimeoutPhase)
+	var dnsErr *DNSError
+	if assert.True(t, errors.As(err, &dnsErr)) {
+		assert.Equal(t, "slow.example.com", dnsErr.Domain)
+	}
+	assert.True(t, errors.Is(err, ErrTimeout))
+}
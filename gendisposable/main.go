@@ -0,0 +1,318 @@
+// gendisposable generates the table of disposable domains from local source snapshots.
+//
+// Each source is a text file with one domain per line. It starts with a header of
+// metadata lines like "# source: https://..." and "# license: MIT", which are reproduced
+// in the generated file. The entries are normalized, converted to punycode and validated.
+// Duplicates and the domains of the allowlist are dropped. Subdomains of listed domains are kept,
+// so that the table contains all entries of the sources, which are no false positives.
+//
+// Usage:
+//
+//	gendisposable [-allowlist file] [-o file] [-package name] source...
+//
+// A source may be a file or a directory, of which all .txt files are read.
+// The generator works offline, new snapshots have to be downloaded into the sources before.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "gendisposable:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout io.Writer) error {
+	f := flag.NewFlagSet("gendisposable", flag.ContinueOnError)
+	allowlistFile := f.String("allowlist", "", "A file with domains, which are no disposable domains")
+	output := f.String("o", "disposable_list.go", "The generated go file")
+	pkg := f.String("package", "mailck", "The package of the generated go file")
+	if err := f.Parse(args); err != nil {
+		return err
+	}
+	if f.NArg() == 0 {
+		return fmt.Errorf("no sources given")
+	}
+
+	sources, err := readSources(f.Args())
+	if err != nil {
+		return err
+	}
+	var allowlist map[string]bool
+	if *allowlistFile != "" {
+		if allowlist, err = readAllowlist(*allowlistFile); err != nil {
+			return err
+		}
+	}
+
+	domains, stats := merge(sources, allowlist)
+	src, err := generate(*pkg, sources, domains)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(*output, src, 0644); err != nil {
+		return err
+	}
+	return writeStats(stdout, stats, len(domains))
+}
+
+// source is a snapshot of a list of disposable domains.
+type source struct {
+	// Name is the file name of the snapshot
+	Name string
+	// Meta contains the header lines like source, license and attribution
+	Meta    map[string]string
+	Entries []entry
+}
+
+type entry struct {
+	Line   int
+	Domain string
+}
+
+// requiredMeta are the header fields, which every source needs
+var requiredMeta = []string{"source", "license"}
+
+func readSources(paths []string) ([]*source, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		matches, err := filepath.Glob(filepath.Join(path, "*.txt"))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, matches...)
+	}
+	sort.Strings(files)
+
+	var sources []*source
+	for _, file := range files {
+		s, err := readSource(file)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, s)
+	}
+	return sources, nil
+}
+
+// readSource reads a source and checks its metadata.
+func readSource(file string) (*source, error) {
+	s, err := readFile(file)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range requiredMeta {
+		if s.Meta[key] == "" {
+			return nil, fmt.Errorf("%v: missing metadata %q in the header", file, key)
+		}
+	}
+	return s, nil
+}
+
+func readFile(file string) (*source, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	s := &source{Name: filepath.Base(file), Meta: map[string]string{}}
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(text, "#") {
+			// metadata is only read from the header
+			if key, value, ok := strings.Cut(strings.TrimSpace(text[1:]), ":"); ok && len(s.Entries) == 0 {
+				s.Meta[strings.ToLower(strings.TrimSpace(key))] = strings.TrimSpace(value)
+			}
+			continue
+		}
+		if text != "" {
+			s.Entries = append(s.Entries, entry{Line: line, Domain: text})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%v: %v", file, err)
+	}
+	return s, nil
+}
+
+func readAllowlist(file string) (map[string]bool, error) {
+	s, err := readFile(file)
+	if err != nil {
+		return nil, err
+	}
+	allowlist := map[string]bool{}
+	for _, e := range s.Entries {
+		domain, err := normalize(e.Domain)
+		if err != nil {
+			return nil, fmt.Errorf("%v:%v: %v", file, e.Line, err)
+		}
+		allowlist[domain] = true
+	}
+	return allowlist, nil
+}
+
+// normalize returns the domain of an entry in lowercase punycode form.
+// Wildcards like *.example.com, a leading @ and a trailing dot are removed.
+func normalize(raw string) (string, error) {
+	domain := strings.TrimSpace(raw)
+	if i := strings.Index(domain, "#"); i != -1 {
+		domain = strings.TrimSpace(domain[:i])
+	}
+	domain = strings.TrimPrefix(domain, "*.")
+	domain = strings.TrimPrefix(domain, "@")
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+
+	ascii, err := idna.Lookup.ToASCII(domain)
+	if err != nil {
+		return "", fmt.Errorf("invalid domain %q: %v", raw, err)
+	}
+	if err := validate(ascii); err != nil {
+		return "", fmt.Errorf("invalid domain %q: %v", raw, err)
+	}
+	return ascii, nil
+}
+
+// validate checks the syntax of a domain in punycode form
+// and rejects public suffixes of the ICANN section like co.uk, which can't belong to a mail provider.
+// Private suffixes like github.io are accepted, because they are operated by a single company.
+func validate(domain string) error {
+	if domain == "" {
+		return fmt.Errorf("empty domain")
+	}
+	if len(domain) > 253 {
+		return fmt.Errorf("domain too long")
+	}
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return fmt.Errorf("missing top level domain")
+	}
+	for _, label := range labels {
+		if label == "" || len(label) > 63 {
+			return fmt.Errorf("invalid label length")
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return fmt.Errorf("label starts or ends with a hyphen")
+		}
+		for i := 0; i < len(label); i++ {
+			if c := label[i]; !('a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-') {
+				return fmt.Errorf("invalid character %q", c)
+			}
+		}
+	}
+	if suffix, icann := publicsuffix.PublicSuffix(domain); suffix == domain && icann {
+		return fmt.Errorf("public suffix")
+	}
+	return nil
+}
+
+// sourceStats counts the entries of a source by their fate.
+type sourceStats struct {
+	Name        string
+	Entries     int
+	Added       int
+	Duplicates  int
+	Allowlisted int
+	Invalid     []string
+}
+
+// merge returns the sorted domains of all sources.
+func merge(sources []*source, allowlist map[string]bool) ([]string, []*sourceStats) {
+	// origin is the source, which added a domain first
+	origin := map[string]*sourceStats{}
+	var stats []*sourceStats
+	for _, s := range sources {
+		st := &sourceStats{Name: s.Name, Entries: len(s.Entries)}
+		stats = append(stats, st)
+		for _, e := range s.Entries {
+			domain, err := normalize(e.Domain)
+			switch {
+			case err != nil:
+				st.Invalid = append(st.Invalid, fmt.Sprintf("%v:%v: %v", s.Name, e.Line, err))
+			case allowlist[domain]:
+				st.Allowlisted++
+			case origin[domain] != nil:
+				st.Duplicates++
+			default:
+				origin[domain] = st
+				st.Added++
+			}
+		}
+	}
+
+	domains := make([]string, 0, len(origin))
+	for domain := range origin {
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+	return domains, stats
+}
+
+// generate returns the formatted go source of the DisposableDomains table.
+func generate(pkg string, sources []*source, domains []string) ([]byte, error) {
+	b := &bytes.Buffer{}
+	fmt.Fprintf(b, "// Code generated by gendisposable; DO NOT EDIT.\n\n")
+	fmt.Fprintf(b, "package %v\n\n", pkg)
+	fmt.Fprintf(b, "// DisposableDomains is a list of fake mail providers.\n")
+	fmt.Fprintf(b, "// It is merged from the following sources:\n")
+	for _, s := range sources {
+		fmt.Fprintf(b, "//\n")
+		fmt.Fprintf(b, "//   - %v: %v\n", s.Name, s.Meta["source"])
+		fmt.Fprintf(b, "//     License: %v\n", s.Meta["license"])
+		if attribution := s.Meta["attribution"]; attribution != "" {
+			fmt.Fprintf(b, "//     %v\n", attribution)
+		}
+		if retrieved := s.Meta["retrieved"]; retrieved != "" {
+			fmt.Fprintf(b, "//     Retrieved: %v\n", retrieved)
+		}
+	}
+	fmt.Fprintf(b, "var DisposableDomains = map[string]bool{\n")
+	for _, domain := range domains {
+		fmt.Fprintf(b, "\t%q: true,\n", domain)
+	}
+	fmt.Fprintf(b, "}\n")
+	return format.Source(b.Bytes())
+}
+
+func writeStats(w io.Writer, stats []*sourceStats, total int) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(tw, "source\tentries\tadded\tduplicates\tallowlisted\tinvalid\t\n")
+	for _, st := range stats {
+		fmt.Fprintf(tw, "%v\t%v\t%v\t%v\t%v\t%v\t\n",
+			st.Name, st.Entries, st.Added, st.Duplicates, st.Allowlisted, len(st.Invalid))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	for _, st := range stats {
+		for _, invalid := range st.Invalid {
+			fmt.Fprintf(w, "skipped %v\n", invalid)
+		}
+	}
+	_, err := fmt.Fprintf(w, "%v domains\n", total)
+	return err
+}
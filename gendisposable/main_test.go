@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_normalize(t *testing.T) {
+	tests := []struct {
+		raw      string
+		expected string
+		valid    bool
+	}{
+		{"mailinator.com", "mailinator.com", true},
+		{"  Mailinator.COM.  ", "mailinator.com", true},
+		{"*.mailinator.com", "mailinator.com", true},
+		{"@mailinator.com", "mailinator.com", true},
+		{"mailinator.com # comment", "mailinator.com", true},
+		{"wegwerf-bücher.de", "xn--wegwerf-bcher-4ob.de", true},
+		{"github.io", "github.io", true},
+		{"", "", false},
+		{"localhost", "", false},
+		{"co.uk", "", false},
+		{"com", "", false},
+		{"-foo.com", "", false},
+		{"foo..com", "", false},
+		{"foo_bar.com", "", false},
+		{"http://foo.com", "", false},
+	}
+	for _, test := range tests {
+		t.Run(test.raw, func(t *testing.T) {
+			domain, err := normalize(test.raw)
+			assert.Equal(t, test.valid, err == nil, "error: %v", err)
+			assert.Equal(t, test.expected, domain)
+		})
+	}
+}
+
+func Test_readSource_MissingMetadata(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "list.txt")
+	writeFile(t, file, "# source: https://example.com/list\nfoo.com\n")
+
+	_, err := readSource(file)
+	assert.EqualError(t, err, file+`: missing metadata "license" in the header`)
+}
+
+func Test_run(t *testing.T) {
+	dir := t.TempDir()
+	sources := filepath.Join(dir, "sources")
+	assert.NoError(t, os.Mkdir(sources, 0755))
+	writeFile(t, filepath.Join(sources, "a.txt"), `# source: https://example.com/a
+# license: MIT
+# attribution: Copyright (c) A
+# retrieved: 2024-01-02
+throwaway.example.com
+Throwaway.example.com
+x.throwaway.example.com
+legit.example.com
+co.uk
+`)
+	writeFile(t, filepath.Join(sources, "b.txt"), `# source: https://example.com/b
+# license: CC0-1.0
+# a comment after the header: not metadata
+trash.example.org
+throwaway.example.com
+`)
+	writeFile(t, filepath.Join(sources, "README.md"), "ignored\n")
+	allowlist := filepath.Join(dir, "allowlist.txt")
+	writeFile(t, allowlist, "# false positives\nlegit.example.com\n")
+	output := filepath.Join(dir, "list.go")
+
+	stdout := &bytes.Buffer{}
+	err := run([]string{"-allowlist", allowlist, "-o", output, "-package", "foo", sources}, stdout)
+	assert.NoError(t, err)
+
+	generated, err := os.ReadFile(output)
+	assert.NoError(t, err)
+	assert.Equal(t, `// Code generated by gendisposable; DO NOT EDIT.
+
+package foo
+
+// DisposableDomains is a list of fake mail providers.
+// It is merged from the following sources:
+//
+//   - a.txt: https://example.com/a
+//     License: MIT
+//     Copyright (c) A
+//     Retrieved: 2024-01-02
+//
+//   - b.txt: https://example.com/b
+//     License: CC0-1.0
+var DisposableDomains = map[string]bool{
+	"throwaway.example.com":   true,
+	"trash.example.org":       true,
+	"x.throwaway.example.com": true,
+}
+`, string(generated))
+
+	assert.Equal(t, `  source  entries  added  duplicates  allowlisted  invalid
+   a.txt        5      2           1            1        1
+   b.txt        2      1           1            0        0
+skipped a.txt:9: invalid domain "co.uk": public suffix
+3 domains
+`, stdout.String())
+}
+
+func Test_run_Errors(t *testing.T) {
+	assert.Error(t, run([]string{}, &bytes.Buffer{}))
+	assert.Error(t, run([]string{filepath.Join(t.TempDir(), "missing")}, &bytes.Buffer{}))
+	assert.Error(t, run([]string{"-unknown"}, &bytes.Buffer{}))
+}
+
+func writeFile(t *testing.T, name, content string) {
+	t.Helper()
+	assert.NoError(t, os.WriteFile(name, []byte(content), 0644))
+}
@@ -0,0 +1,117 @@
+// genfreeprovider generates the table of free mail providers from a list of domains.
+//
+// The list is a text file with one domain per line. Empty lines and lines starting with #
+// are ignored. The domains are converted to lowercase punycode, sorted and deduplicated.
+// Invalid domains stop the generation, because the list is maintained by hand.
+//
+// Usage:
+//
+//	genfreeprovider [-o file] [-package name] file
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"golang.org/x/net/idna"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "genfreeprovider:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout io.Writer) error {
+	f := flag.NewFlagSet("genfreeprovider", flag.ContinueOnError)
+	output := f.String("o", "free_provider_list.go", "The generated go file")
+	pkg := f.String("package", "mailck", "The package of the generated go file")
+	if err := f.Parse(args); err != nil {
+		return err
+	}
+	if f.NArg() != 1 {
+		return fmt.Errorf("expected one list of domains")
+	}
+
+	domains, err := readDomains(f.Arg(0))
+	if err != nil {
+		return err
+	}
+	src, err := generate(*pkg, filepath.Base(f.Arg(0)), domains)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(*output, src, 0644); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(stdout, "%v domains\n", len(domains))
+	return err
+}
+
+// readDomains returns the sorted domains of the file in punycode form.
+func readDomains(file string) ([]string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	set := map[string]bool{}
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		domain, err := normalize(text)
+		if err != nil {
+			return nil, fmt.Errorf("%v:%v: %v", file, line, err)
+		}
+		set[domain] = true
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%v: %v", file, err)
+	}
+
+	domains := make([]string, 0, len(set))
+	for domain := range set {
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+	return domains, nil
+}
+
+// normalize returns the domain in lowercase punycode form.
+func normalize(raw string) (string, error) {
+	domain, err := idna.Lookup.ToASCII(strings.TrimSuffix(strings.ToLower(raw), "."))
+	if err != nil {
+		return "", fmt.Errorf("invalid domain %q: %v", raw, err)
+	}
+	if !strings.Contains(domain, ".") || strings.ContainsAny(domain, " *@/") {
+		return "", fmt.Errorf("invalid domain %q", raw)
+	}
+	return domain, nil
+}
+
+// generate returns the formatted go source of the FreeProviderDomains table.
+func generate(pkg, list string, domains []string) ([]byte, error) {
+	b := &bytes.Buffer{}
+	fmt.Fprintf(b, "// Code generated by genfreeprovider; DO NOT EDIT.\n\n")
+	fmt.Fprintf(b, "package %v\n\n", pkg)
+	fmt.Fprintf(b, "// FreeProviderDomains is a list of free mail providers.\n")
+	fmt.Fprintf(b, "// It is generated from %v.\n", list)
+	fmt.Fprintf(b, "var FreeProviderDomains = map[string]bool{\n")
+	for _, domain := range domains {
+		fmt.Fprintf(b, "\t%q: true,\n", domain)
+	}
+	fmt.Fprintf(b, "}\n")
+	return format.Source(b.Bytes())
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_run(t *testing.T) {
+	dir := t.TempDir()
+	list := filepath.Join(dir, "domains.txt")
+	writeFile(t, list, "# free mail providers\nGMX.de\n\nweb.de\ngmx.de.\nbücher.example\n")
+	output := filepath.Join(dir, "list.go")
+
+	stdout := &bytes.Buffer{}
+	err := run([]string{"-o", output, "-package", "foo", list}, stdout)
+	assert.NoError(t, err)
+
+	generated, err := os.ReadFile(output)
+	assert.NoError(t, err)
+	assert.Equal(t, `// Code generated by genfreeprovider; DO NOT EDIT.
+
+package foo
+
+// FreeProviderDomains is a list of free mail providers.
+// It is generated from domains.txt.
+var FreeProviderDomains = map[string]bool{
+	"gmx.de":                true,
+	"web.de":                true,
+	"xn--bcher-kva.example": true,
+}
+`, string(generated))
+	assert.Equal(t, "3 domains\n", stdout.String())
+}
+
+func Test_run_Errors(t *testing.T) {
+	dir := t.TempDir()
+	list := filepath.Join(dir, "domains.txt")
+	writeFile(t, list, "gmx.de\nlocalhost\n")
+	err := run([]string{"-o", filepath.Join(dir, "list.go"), list}, &bytes.Buffer{})
+	assert.EqualError(t, err, list+`:2: invalid domain "localhost"`)
+
+	assert.Error(t, run([]string{}, &bytes.Buffer{}))
+	assert.Error(t, run([]string{filepath.Join(dir, "missing.txt")}, &bytes.Buffer{}))
+}
+
+func writeFile(t *testing.T, name, content string) {
+	t.Helper()
+	assert.NoError(t, os.WriteFile(name, []byte(content), 0644))
+}
@@ -0,0 +1,50 @@
+package mailck
+
+import (
+	"golang.org/x/net/idna"
+	"strings"
+	"unicode/utf8"
+)
+
+// toASCIIDomain converts an internationalized domain like müller.de into the
+// A-label form xn--mller-kva.de (IDNA2008), which is used for DNS and SMTP.
+// ASCII domains are returned unchanged.
+func toASCIIDomain(domain string) (string, error) {
+	if isASCII(domain) {
+		return domain, nil
+	}
+	return idna.Lookup.ToASCII(domain)
+}
+
+// asciiAddress returns the address with the domain in A-label form.
+// The address is returned unchanged, if the domain can't be converted.
+func asciiAddress(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at == -1 {
+		return email
+	}
+	domain, err := toASCIIDomain(email[at+1:])
+	if err != nil {
+		return email
+	}
+	return email[:at+1] + domain
+}
+
+// needsSMTPUTF8 returns true, if the local part of the address contains non ASCII characters,
+// so that the mailserver has to support SMTPUTF8 (RFC 6531).
+func needsSMTPUTF8(email string) bool {
+	at := strings.LastIndex(email, "@")
+	if at == -1 {
+		return !isASCII(email)
+	}
+	return !isASCII(email[:at])
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,136 @@
+package mailck
+
+import (
+	"bufio"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestParseAddress_Internationalized(t *testing.T) {
+	address, err := ParseAddress("jörg@Müller.de", PracticalProfile)
+	assert.NoError(t, err)
+	assert.Equal(t, "Müller.de", address.Domain)
+	assert.Equal(t, "xn--mller-kva.de", address.ASCIIDomain)
+	assert.True(t, address.IsInternationalized())
+
+	address, err = ParseAddress("foo@例子.广告", PracticalProfile)
+	assert.NoError(t, err)
+	assert.Equal(t, "xn--fsqu00a.xn--4rr70v", address.ASCIIDomain)
+	assert.False(t, address.IsInternationalized())
+
+	address, err = ParseAddress("foo@example.com", PracticalProfile)
+	assert.NoError(t, err)
+	assert.Equal(t, "example.com", address.ASCIIDomain)
+}
+
+func Test_asciiAddress(t *testing.T) {
+	assert.Equal(t, "jörg@xn--mller-kva.de", asciiAddress("jörg@müller.de"))
+	assert.Equal(t, "foo@example.com", asciiAddress("foo@example.com"))
+	assert.Equal(t, "foo@ä_b.de", asciiAddress("foo@ä_b.de"))
+	assert.Equal(t, "xxx", asciiAddress("xxx"))
+	assert.True(t, needsSMTPUTF8("jörg@example.com"))
+	assert.False(t, needsSMTPUTF8("foo@müller.de"))
+}
+
+func TestCheckDisposable_Internationalized(t *testing.T) {
+	assert.True(t, CheckDisposable("foo@MAILINATOR.com"))
+	assert.False(t, CheckDisposable("foo@müller.de"))
+}
+
+func TestChecker_SMTPUTF8(t *testing.T) {
+	tests := []struct {
+		title      string
+		address    string
+		extensions []string
+		result     Result
+		mailFrom   string
+		rcptTo     string
+	}{
+		{"ascii address", "foo@müller.de", nil, Valid,
+			"MAIL FROM:<noreply@mancke.net>", "RCPT TO:<foo@xn--mller-kva.de>"},
+		{"utf8 address", "jörg@müller.de", []string{"SMTPUTF8"}, Valid,
+			"MAIL FROM:<noreply@mancke.net> SMTPUTF8", "RCPT TO:<jörg@xn--mller-kva.de>"},
+		{"utf8 not supported", "jörg@müller.de", []string{"8BITMIME"}, SMTPUTF8Unsupported,
+			"", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			port, commands := startRecordingServer(t, test.extensions...)
+			r := NewStaticResolver().AddMX("xn--mller-kva.de", "127.0.0.1")
+			c := NewChecker(WithFromEmail("noreply@mancke.net"), WithSMTPPort(port), WithResolver(r))
+
+			result, err := c.CheckWithContext(context.Background(), test.address)
+			assert.NoError(t, err)
+			assert.True(t, result.Is(test.result))
+
+			sent := commands()
+			if test.mailFrom == "" {
+				for _, cmd := range sent {
+					assert.False(t, strings.HasPrefix(cmd, "MAIL"), "unexpected command %v", cmd)
+				}
+				return
+			}
+			assert.Contains(t, sent, test.mailFrom)
+			assert.Contains(t, sent, test.rcptTo)
+		})
+	}
+}
+
+// startRecordingServer starts an SMTP server, which accepts all commands and advertises the extensions.
+// It returns the port and a function, which returns the received commands.
+func startRecordingServer(t *testing.T, extensions ...string) (int, func() []string) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	var mutex sync.Mutex
+	var commands []string
+	done := make(chan struct{})
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer close(done)
+		defer conn.Close()
+		conn.SetDeadline(time.Now().Add(5 * time.Second))
+		conn.Write([]byte("220 localhost ESMTP test\r\n"))
+		r := bufio.NewReader(conn)
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			cmd := strings.TrimRight(line, "\r\n")
+			mutex.Lock()
+			commands = append(commands, cmd)
+			mutex.Unlock()
+			switch {
+			case strings.HasPrefix(cmd, "EHLO"):
+				reply := "250-localhost\r\n"
+				for _, ext := range extensions {
+					reply += "250-" + ext + "\r\n"
+				}
+				conn.Write([]byte(reply + "250 HELP\r\n"))
+			case cmd == "QUIT":
+				conn.Write([]byte("221 Bye\r\n"))
+				return
+			default:
+				conn.Write([]byte("250 Ok\r\n"))
+			}
+		}
+	}()
+	return ln.Addr().(*net.TCPAddr).Port, func() []string {
+		<-done
+		mutex.Lock()
+		defer mutex.Unlock()
+		return append([]string(nil), commands...)
+	}
+}
@@ -4,23 +4,31 @@ import (
 	"flag"
 	"github.com/caarlos0/env"
 	"os"
+	"time"
 )
 
 func DefaultConfig() Config {
 	return Config{
-		Host:      "localhost",
-		Port:      "6788",
-		LogLevel:  "info",
-		FromEmail: "noreply@mailck.io",
+		Host:                   "localhost",
+		Port:                   "6788",
+		LogLevel:               "info",
+		FromEmail:              "noreply@mailck.io",
+		DisposableListInterval: time.Minute,
 	}
 }
 
 type Config struct {
-	Host        string `env:"MAILCKD_HOST"`
-	Port        string `env:"MAILCKD_PORT"`
-	LogLevel    string `env:"MAILCKD_LOG_LEVEL"`
-	TextLogging bool   `env:"MAILCKD_TEXT_LOGGING"`
-	FromEmail   string `env:"MAILCKD_FROM_EMAIL"`
+	Host                   string        `env:"MAILCKD_HOST"`
+	Port                   string        `env:"MAILCKD_PORT"`
+	LogLevel               string        `env:"MAILCKD_LOG_LEVEL"`
+	TextLogging            bool          `env:"MAILCKD_TEXT_LOGGING"`
+	FromEmail              string        `env:"MAILCKD_FROM_EMAIL"`
+	HeloName               string        `env:"MAILCKD_HELO_NAME"`
+	DisposableList         string        `env:"MAILCKD_DISPOSABLE_LIST"`
+	DisposableListInterval time.Duration `env:"MAILCKD_DISPOSABLE_LIST_INTERVAL"`
+	DisposableMXCheck      bool          `env:"MAILCKD_DISPOSABLE_MX_CHECK"`
+	Allowlist              string        `env:"MAILCKD_ALLOWLIST"`
+	Denylist               string        `env:"MAILCKD_DENYLIST"`
 }
 
 func (c Config) HostPort() string {
@@ -50,6 +58,13 @@ func readConfig(f *flag.FlagSet, args []string) (*Config, error) {
 	f.StringVar(&config.LogLevel, "log-level", config.LogLevel, "The log level")
 	f.BoolVar(&config.TextLogging, "text-logging", config.TextLogging, "Log in text format instead of json")
 	f.StringVar(&config.FromEmail, "from-email", config.FromEmail, "The from email when connecting to the mailserver")
+	f.StringVar(&config.HeloName, "helo-name", config.HeloName, "The HELO name when connecting to the mailserver (default: domain of the from email)")
+
+	f.StringVar(&config.DisposableList, "disposable-list", config.DisposableList, "A file or directory with additional disposable domains, one per line (reloaded on SIGHUP)")
+	f.DurationVar(&config.DisposableListInterval, "disposable-list-interval", config.DisposableListInterval, "The interval for checking the disposable list for changes (0: only reload on SIGHUP)")
+	f.BoolVar(&config.DisposableMXCheck, "disposable-mx-check", config.DisposableMXCheck, "Detect disposable domains by the mailservers of known disposable mail services")
+	f.StringVar(&config.Allowlist, "allowlist", config.Allowlist, "A file with addresses, domains, *.wildcards or /local part regexps/, which are always valid")
+	f.StringVar(&config.Denylist, "denylist", config.Denylist, "A file with addresses, domains, *.wildcards or /local part regexps/, which are always invalid")
 
 	// Arguments variables
 	err = f.Parse(args)
@@ -5,6 +5,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"os"
 	"testing"
+	"time"
 )
 
 func TestConfig_ReadConfigDefaults(t *testing.T) {
@@ -23,14 +24,26 @@ func TestConfig_ReadConfig(t *testing.T) {
 		"--log-level=loglevel",
 		"--text-logging=true",
 		"--from-email=foo@example.com",
+		"--helo-name=mx.example.com",
+		"--disposable-list=/etc/mailckd/disposable",
+		"--disposable-list-interval=10s",
+		"--disposable-mx-check=true",
+		"--allowlist=/etc/mailckd/allowlist",
+		"--denylist=/etc/mailckd/denylist",
 	}
 
 	expected := &Config{
-		Host:        "host",
-		Port:        "port",
-		LogLevel:    "loglevel",
-		TextLogging: true,
-		FromEmail:   "foo@example.com",
+		Host:                   "host",
+		Port:                   "port",
+		LogLevel:               "loglevel",
+		TextLogging:            true,
+		FromEmail:              "foo@example.com",
+		HeloName:               "mx.example.com",
+		DisposableList:         "/etc/mailckd/disposable",
+		DisposableListInterval: 10 * time.Second,
+		DisposableMXCheck:      true,
+		Allowlist:              "/etc/mailckd/allowlist",
+		Denylist:               "/etc/mailckd/denylist",
 	}
 
 	cfg, err := readConfig(flag.NewFlagSet("", flag.ContinueOnError), input)
@@ -49,13 +62,31 @@ func TestConfig_ReadConfigFromEnv(t *testing.T) {
 	defer os.Unsetenv("MAILCKD_TEXT_LOGGING")
 	assert.NoError(t, os.Setenv("MAILCKD_FROM_EMAIL", "foo@example.com"))
 	defer os.Unsetenv("MAILCKD_FROM_EMAIL")
+	assert.NoError(t, os.Setenv("MAILCKD_HELO_NAME", "mx.example.com"))
+	defer os.Unsetenv("MAILCKD_HELO_NAME")
+	assert.NoError(t, os.Setenv("MAILCKD_DISPOSABLE_LIST", "/etc/mailckd/disposable"))
+	defer os.Unsetenv("MAILCKD_DISPOSABLE_LIST")
+	assert.NoError(t, os.Setenv("MAILCKD_DISPOSABLE_LIST_INTERVAL", "10s"))
+	defer os.Unsetenv("MAILCKD_DISPOSABLE_LIST_INTERVAL")
+	assert.NoError(t, os.Setenv("MAILCKD_DISPOSABLE_MX_CHECK", "true"))
+	defer os.Unsetenv("MAILCKD_DISPOSABLE_MX_CHECK")
+	assert.NoError(t, os.Setenv("MAILCKD_ALLOWLIST", "/etc/mailckd/allowlist"))
+	defer os.Unsetenv("MAILCKD_ALLOWLIST")
+	assert.NoError(t, os.Setenv("MAILCKD_DENYLIST", "/etc/mailckd/denylist"))
+	defer os.Unsetenv("MAILCKD_DENYLIST")
 
 	expected := &Config{
-		Host:        "host",
-		Port:        "port",
-		LogLevel:    "loglevel",
-		TextLogging: true,
-		FromEmail:   "foo@example.com",
+		Host:                   "host",
+		Port:                   "port",
+		LogLevel:               "loglevel",
+		TextLogging:            true,
+		FromEmail:              "foo@example.com",
+		HeloName:               "mx.example.com",
+		DisposableList:         "/etc/mailckd/disposable",
+		DisposableListInterval: 10 * time.Second,
+		DisposableMXCheck:      true,
+		Allowlist:              "/etc/mailckd/allowlist",
+		Denylist:               "/etc/mailckd/denylist",
 	}
 
 	cfg, err := readConfig(flag.NewFlagSet("", flag.ContinueOnError), []string{})
@@ -1,12 +1,14 @@
 package main
 
 import (
+	"context"
 	"github.com/smancke/mailck"
 	"github.com/tarent/lib-compose/logging"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 )
 
 const applicationName = "mailckd"
@@ -18,26 +20,115 @@ func main() {
 		return // return here for unittesing
 	}
 
+	options := []mailck.Option{
+		mailck.WithFromEmail(config.FromEmail),
+		mailck.WithHeloName(config.HeloName),
+		mailck.WithDisposableMXCheck(config.DisposableMXCheck),
+	}
+	if config.DisposableList != "" {
+		disposableList, err := mailck.LoadDisposableList(config.DisposableList)
+		if err != nil {
+			exit(nil, err)
+			return // return here for unittesing
+		}
+		watchDisposableList(disposableList, config.DisposableListInterval)
+		options = append(options, mailck.WithDisposableList(disposableList))
+	}
+	if config.Allowlist != "" {
+		allowlist, err := mailck.LoadAddressList(config.Allowlist)
+		if err != nil {
+			exit(nil, err)
+			return // return here for unittesing
+		}
+		options = append(options, mailck.WithAllowlist(allowlist))
+	}
+	if config.Denylist != "" {
+		denylist, err := mailck.LoadAddressList(config.Denylist)
+		if err != nil {
+			exit(nil, err)
+			return // return here for unittesing
+		}
+		options = append(options, mailck.WithDenylist(denylist))
+	}
+
+	checker := mailck.NewChecker(options...)
+	if err := checker.Err(); err != nil {
+		exit(nil, err)
+		return // return here for unittesing
+	}
+
 	logShutdownEvent()
 
 	logging.LifecycleStart(applicationName, config)
 
-	checkFunc := func(checkEmail string) (result mailck.Result, err error) {
-		return mailck.Check(config.FromEmail, checkEmail)
+	checkFunc, reportFunc := checkFunctions(checker)
+	optionsFunc := func(options CheckOptions) (MailValidationFunction, MailReportFunction) {
+		return checkFunctions(checkerWithOptions(checker, options))
 	}
-	handlerChain := logging.NewLogMiddleware(NewValidationHandler(checkFunc))
+	handler := NewValidationHandler(checkFunc).WithReportFunction(reportFunc).WithOptionsFunction(optionsFunc)
+	handlerChain := logging.NewLogMiddleware(handler)
 
 	exit(nil, http.ListenAndServe(config.HostPort(), handlerChain))
 }
 
+// checkerWithOptions returns a copy of the checker, which is configured by the options of a request.
+func checkerWithOptions(checker *mailck.Checker, options CheckOptions) *mailck.Checker {
+	if options.Level != "" {
+		checker = checker.AtLevel(options.Level)
+	}
+	if options.RejectFreeProvider {
+		checker = checker.RejectingFreeProviders(true)
+	}
+	return checker
+}
+
+// checkFunctions returns the check and report functions of the checker.
+func checkFunctions(checker *mailck.Checker) (MailValidationFunction, MailReportFunction) {
+	checkFunc := func(checkEmail string) (result mailck.Result, details mailck.Details, err error) {
+		report, err := checker.CheckWithReport(context.Background(), checkEmail)
+		return report.Result, report.Details, err
+	}
+	reportFunc := func(checkEmail string) (report *mailck.CheckReport, err error) {
+		return checker.CheckWithReport(context.Background(), checkEmail)
+	}
+	return checkFunc, reportFunc
+}
+
 func logShutdownEvent() {
 	go func() {
-		c := make(chan os.Signal)
+		c := make(chan os.Signal, 1)
 		signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
 		exit(<-c, nil)
 	}()
 }
 
+// watchDisposableList reloads the list on SIGHUP and,
+// if the interval is not zero, when the files of the list were modified.
+func watchDisposableList(list *mailck.DisposableList, interval time.Duration) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	var tick <-chan time.Time
+	if interval > 0 {
+		tick = time.NewTicker(interval).C
+	}
+	go func() {
+		for {
+			select {
+			case <-hup:
+			case <-tick:
+				if !list.Modified() {
+					continue
+				}
+			}
+			if err := list.Reload(); err != nil {
+				logging.Logger.WithError(err).Error("reload of the disposable list failed")
+				continue
+			}
+			logging.Logger.WithField("domains", list.Len()).Info("reloaded the disposable list")
+		}
+	}()
+}
+
 func exit(signal os.Signal, err error) {
 	logging.LifecycleStop(applicationName, signal, err)
 	exitCode := 0
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -26,6 +27,55 @@ func Test_ExitOnWrongLoglevel(t *testing.T) {
 	assert.Equal(t, 1, exitCode)
 }
 
+func Test_ExitOnInvalidConfig(t *testing.T) {
+	denylist := filepath.Join(t.TempDir(), "denylist")
+	assert.NoError(t, os.WriteFile(denylist, []byte("/[unclosed/\n"), 0644))
+
+	tests := []struct {
+		title   string
+		args    []string
+		message string
+	}{
+		{"invalid helo name", []string{"-helo-name=not a hostname"}, "invalid HELO name"},
+		{"missing disposable list", []string{"-disposable-list=/does/not/exist"}, "/does/not/exist"},
+		{"invalid denylist", []string{"-denylist=" + denylist}, "invalid list entry"},
+	}
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			exitCode := -1
+			osExitOriginal := osExit
+			defer func() { osExit = osExitOriginal }()
+			osExit = func(code int) {
+				exitCode = code
+			}
+			originalArgs := os.Args
+			os.Args = append([]string{"mailckd"}, test.args...)
+			defer func() { os.Args = originalArgs }()
+
+			output := captureStderr(t, main)
+			assert.Equal(t, 1, exitCode)
+			assert.Contains(t, output, test.message)
+		})
+	}
+}
+
+// captureStderr returns the output of f to os.Stderr, where the logger writes to.
+func captureStderr(t *testing.T, f func()) string {
+	t.Helper()
+	file, err := os.CreateTemp(t.TempDir(), "stderr")
+	assert.NoError(t, err)
+	defer file.Close()
+
+	stderrOriginal := os.Stderr
+	os.Stderr = file
+	defer func() { os.Stderr = stderrOriginal }()
+	f()
+
+	output, err := os.ReadFile(file.Name())
+	assert.NoError(t, err)
+	return string(output)
+}
+
 func Test_BasicEndToEnd(t *testing.T) {
 	originalArgs := os.Args
 	os.Args = []string{"mailckd", "-host=localhost", "-port=3002", "-text-logging=false"}
@@ -35,7 +85,7 @@ func Test_BasicEndToEnd(t *testing.T) {
 
 	time.Sleep(time.Second)
 
-	r, err := http.Post("http://localhost:3002/api/verify", "application/x-www-form-urlencoded", strings.NewReader(`mail=foo@example.com`))
+	r, err := http.Post("http://localhost:3002/api/verify", "application/x-www-form-urlencoded", strings.NewReader(`mail=foo@example.invalid`))
 	assert.NoError(t, err)
 
 	assert.Equal(t, 200, r.StatusCode)
@@ -8,20 +8,48 @@ import (
 	"github.com/tarent/lib-compose/logging"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
 )
 
 type parameters struct {
 	Mail    string `json:"mail"`
 	Timeout string `json:"timeout"`
+	Verbose bool   `json:"verbose"`
+	// RejectFreeProvider makes addresses of free mail providers invalid
+	RejectFreeProvider bool `json:"rejectFreeProvider"`
+	// Level is the depth of the check: syntax, domain or mailbox
+	Level string `json:"level"`
 }
 
-// MailValidationFunction checks the checkEmail
-type MailValidationFunction func(checkEmail string) (result mailck.Result, err error)
+// MailValidationFunction checks the checkEmail and returns the details of the checks
+type MailValidationFunction func(checkEmail string) (result mailck.Result, details mailck.Details, err error)
+
+// MailReportFunction checks the checkEmail and returns the details of the check
+type MailReportFunction func(checkEmail string) (report *mailck.CheckReport, err error)
+
+// CheckOptions are the parameters of a request, which change the configuration of the check
+type CheckOptions struct {
+	// Level is the depth of the check, if not empty
+	Level mailck.Level
+	// RejectFreeProvider makes addresses of free mail providers invalid
+	RejectFreeProvider bool
+}
+
+// MailOptionsFunction returns the check and report functions for the options of a request
+type MailOptionsFunction func(options CheckOptions) (MailValidationFunction, MailReportFunction)
+
+// checkResponse is the response without the verbose parameter.
+type checkResponse struct {
+	mailck.Result
+	mailck.Details
+}
 
 // ValidationHandler is a REST handler for mail validation.
 type ValidationHandler struct {
-	checkFunc MailValidationFunction
+	checkFunc   MailValidationFunction
+	reportFunc  MailReportFunction
+	optionsFunc MailOptionsFunction
 }
 
 func NewValidationHandler(checkFunc MailValidationFunction) *ValidationHandler {
@@ -30,6 +58,18 @@ func NewValidationHandler(checkFunc MailValidationFunction) *ValidationHandler {
 	}
 }
 
+// WithReportFunction enables the verbose response, which is requested by the verbose parameter.
+func (h *ValidationHandler) WithReportFunction(reportFunc MailReportFunction) *ValidationHandler {
+	h.reportFunc = reportFunc
+	return h
+}
+
+// WithOptionsFunction enables the level and rejectFreeProvider parameters.
+func (h *ValidationHandler) WithOptionsFunction(optionsFunc MailOptionsFunction) *ValidationHandler {
+	h.optionsFunc = optionsFunc
+	return h
+}
+
 func (h *ValidationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 
@@ -56,17 +96,75 @@ func (h *ValidationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, err := h.checkFunc(p.Mail)
+	checkFunc, reportFunc := h.checkFunc, h.reportFunc
+	if p.Level != "" || p.RejectFreeProvider {
+		if h.optionsFunc == nil {
+			name := "level"
+			if p.Level == "" {
+				name = "rejectFreeProvider"
+			}
+			writeError(w, 400, "clientError", "parameter not supported: "+name)
+			return
+		}
+		checkFunc, reportFunc = h.optionsFunc(CheckOptions{Level: mailck.Level(p.Level), RejectFreeProvider: p.RejectFreeProvider})
+	}
+
+	var response interface{}
+	if p.Verbose && reportFunc != nil {
+		var report *mailck.CheckReport
+		report, err = reportFunc(p.Mail)
+		if report != nil {
+			response = report
+		} else {
+			if err == nil {
+				err = errors.New("missing check report")
+			}
+			response = mailck.ServiceError
+		}
+	} else {
+		var result checkResponse
+		result.Result, result.Details, err = checkFunc(p.Mail)
+		response = result
+	}
 
 	if err != nil {
-		logging.Application(r.Header).WithError(err).WithField("mail", p.Mail).Info("check error")
-		if result == mailck.MailserverError {
-			w.WriteHeader(502)
+		logger := logging.Application(r.Header).WithError(err).WithField("mail", p.Mail)
+		var smtpErr *mailck.SMTPError
+		var dialErr *mailck.DialError
+		var dnsErr *mailck.DNSError
+		var blockedErr *mailck.SenderBlockedError
+		var tempErr *mailck.TemporaryError
+		if errors.As(err, &smtpErr) {
+			logger = logger.WithField("mxHost", smtpErr.Host).WithField("stage", smtpErr.Stage)
+			if smtpErr.Code != 0 {
+				logger = logger.WithField("smtpCode", smtpErr.Code).WithField("enhancedCode", smtpErr.Enhanced.String())
+			}
+		} else if errors.As(err, &dialErr) {
+			logger = logger.WithField("mxHost", dialErr.Host)
+		} else if errors.As(err, &dnsErr) {
+			logger = logger.WithField("domain", dnsErr.Domain)
+		}
+		if errors.As(err, &blockedErr) {
+			logger.WithField("mxHost", blockedErr.Host).Warn("sender blocked by mailserver")
 		} else {
+			logger.Info("check error")
+		}
+
+		switch {
+		case errors.As(err, &tempErr):
+			if tempErr.RetryAfter > 0 {
+				w.Header().Set("Retry-After", strconv.Itoa(int(tempErr.RetryAfter.Seconds())))
+			}
+			w.WriteHeader(503)
+		case errors.Is(err, mailck.ErrTimeout):
+			w.WriteHeader(504)
+		case blockedErr != nil || smtpErr != nil || dialErr != nil || dnsErr != nil:
+			w.WriteHeader(502)
+		default:
 			w.WriteHeader(500)
 		}
 	}
-	b, _ := json.MarshalIndent(result, "", "  ")
+	b, _ := json.MarshalIndent(response, "", "  ")
 	w.Write(b)
 }
 
@@ -89,14 +187,41 @@ func (h *ValidationHandler) readParameters(r *http.Request) (parameters, error)
 	if r.Form.Get("timeout") != "" {
 		p.Timeout = r.Form.Get("timeout")
 	}
+	if r.Form.Get("level") != "" {
+		p.Level = r.Form.Get("level")
+	}
+	if err := readBoolParameter(r, "verbose", &p.Verbose); err != nil {
+		return p, err
+	}
+	if err := readBoolParameter(r, "rejectFreeProvider", &p.RejectFreeProvider); err != nil {
+		return p, err
+	}
 
 	if p.Mail == "" {
 		return p, errors.New("missing parameter: mail")
 	}
+	if p.Level != "" {
+		if _, err := mailck.ParseLevel(p.Level); err != nil {
+			return p, errors.New("invalid parameter: level")
+		}
+	}
 
 	return p, nil
 }
 
+// readBoolParameter sets value, if the form parameter is present.
+func readBoolParameter(r *http.Request, name string, value *bool) error {
+	if r.Form.Get(name) == "" {
+		return nil
+	}
+	b, err := strconv.ParseBool(r.Form.Get(name))
+	if err != nil {
+		return errors.New("invalid parameter: " + name)
+	}
+	*value = b
+	return nil
+}
+
 func writeError(w http.ResponseWriter, code int, resultDetail, message string) {
 	w.WriteHeader(code)
 	fmt.Fprintf(w, `{"result": "error", "resultDetail": "%v", "message": "%v"}`, resultDetail, message)
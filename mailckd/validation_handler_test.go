@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"github.com/smancke/mailck"
@@ -9,14 +10,15 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 )
 
 func testValidationFunction(result mailck.Result, err error) MailValidationFunction {
-	return func(checkEmail string) (mailck.Result, error) {
+	return func(checkEmail string) (mailck.Result, mailck.Details, error) {
 		if checkEmail != "foo@example.com" {
 			panic("wrong email: " + checkEmail)
 		}
-		return result, err
+		return result, mailck.Details{}, err
 	}
 }
 
@@ -125,7 +127,7 @@ func Test_Requests(t *testing.T) {
 		},
 		{
 			title:              "mailserver error",
-			validationFunction: testValidationFunction(mailck.MailserverError, errors.New("some error")),
+			validationFunction: testValidationFunction(mailck.MailserverError, &mailck.SMTPError{Host: "mx.example.com", Stage: mailck.PhaseMailFrom, Reply: mailck.ParseReply(550, "not accepted")}),
 			url:                "/verify?mail=foo%40example.com",
 			method:             "GET",
 			responseCode:       502,
@@ -133,6 +135,46 @@ func Test_Requests(t *testing.T) {
 			resultDetail:       "mailserverError",
 			message:            mailck.MailserverError.Message,
 		},
+		{
+			title:              "network error",
+			validationFunction: testValidationFunction(mailck.NetworkError, &mailck.DialError{Host: "mx.example.com", Addr: "mx.example.com:25", Err: errors.New("connection refused")}),
+			url:                "/verify?mail=foo%40example.com",
+			method:             "GET",
+			responseCode:       502,
+			result:             "error",
+			resultDetail:       "networkError",
+			message:            mailck.NetworkError.Message,
+		},
+		{
+			title:              "timeout",
+			validationFunction: testValidationFunction(mailck.TimeoutError, &mailck.SMTPError{Host: "mx.example.com", Err: context.DeadlineExceeded}),
+			url:                "/verify?mail=foo%40example.com",
+			method:             "GET",
+			responseCode:       504,
+			result:             "error",
+			resultDetail:       "timeoutError",
+			message:            mailck.TimeoutError.Message,
+		},
+		{
+			title:              "sender blocked",
+			validationFunction: testValidationFunction(mailck.SenderBlocked, &mailck.SenderBlockedError{Host: "mx.example.com"}),
+			url:                "/verify?mail=foo%40example.com",
+			method:             "GET",
+			responseCode:       502,
+			result:             "error",
+			resultDetail:       "senderBlocked",
+			message:            mailck.SenderBlocked.Message,
+		},
+		{
+			title:              "temporary failure",
+			validationFunction: testValidationFunction(mailck.TemporaryFailure, &mailck.TemporaryError{Code: 450, Message: "greylisted"}),
+			url:                "/verify?mail=foo%40example.com",
+			method:             "GET",
+			responseCode:       503,
+			result:             "error",
+			resultDetail:       "tryAgainLater",
+			message:            mailck.TemporaryFailure.Message,
+		},
 	}
 
 	for _, test := range tests {
@@ -162,6 +204,222 @@ func Test_Requests(t *testing.T) {
 	}
 }
 
+func Test_VerboseRequests(t *testing.T) {
+	report := &mailck.CheckReport{
+		Result: mailck.MailboxUnavailable,
+		Email:  "foo@example.com",
+		Host:   "mx.example.com",
+		Transcript: []mailck.Exchange{
+			{Host: "mx.example.com", Command: "RCPT TO:<foo@example.com>", Code: 550, Message: "5.1.1 unknown"},
+		},
+	}
+	reportFunc := func(checkEmail string) (*mailck.CheckReport, error) {
+		return report, nil
+	}
+	handler := NewValidationHandler(testValidationFunction(mailck.MailboxUnavailable, nil)).WithReportFunction(reportFunc)
+
+	tests := []struct {
+		url          string
+		body         string
+		responseCode int
+		verbose      bool
+	}{
+		{"/verify?mail=foo%40example.com&verbose=true", "", 200, true},
+		{"/verify?mail=foo%40example.com&verbose=false", "", 200, false},
+		{"/verify?mail=foo%40example.com", "", 200, false},
+		{"/verify", `{"mail": "foo@example.com", "verbose": true}`, 200, true},
+		{"/verify?mail=foo%40example.com&verbose=yesplease", "", 400, false},
+	}
+	for _, test := range tests {
+		t.Run(test.url+test.body, func(t *testing.T) {
+			method := "GET"
+			if test.body != "" {
+				method = "POST"
+			}
+			req, err := http.NewRequest(method, test.url, strings.NewReader(test.body))
+			assert.NoError(t, err)
+			if test.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			resp := httptest.NewRecorder()
+
+			handler.ServeHTTP(resp, req)
+
+			assert.Equal(t, test.responseCode, resp.Code)
+			result := getJson(t, resp)
+			if test.responseCode == 200 {
+				assert.Equal(t, "mailboxUnavailable", result["resultDetail"])
+			}
+			if test.verbose {
+				assert.Equal(t, "mx.example.com", result["host"])
+				assert.Len(t, result["transcript"], 1)
+			} else {
+				assert.NotContains(t, result, "transcript")
+			}
+		})
+	}
+}
+
+func Test_VerboseRequests_MissingReport(t *testing.T) {
+	reportFunc := func(checkEmail string) (*mailck.CheckReport, error) {
+		return nil, errors.New("checker broken")
+	}
+	handler := NewValidationHandler(testValidationFunction(mailck.Valid, nil)).WithReportFunction(reportFunc)
+	req, err := http.NewRequest("GET", "/verify?mail=foo%40example.com&verbose=true", nil)
+	assert.NoError(t, err)
+	resp := httptest.NewRecorder()
+
+	handler.ServeHTTP(resp, req)
+
+	assert.Equal(t, 500, resp.Code)
+	result := getJson(t, resp)
+	assert.Equal(t, "error", result["result"])
+	assert.Equal(t, "serviceError", result["resultDetail"])
+}
+
+func Test_RetryAfterHeader(t *testing.T) {
+	tempErr := &mailck.TemporaryError{Code: 451, Message: "try again in 5 minutes", RetryAfter: 5 * time.Minute}
+	handler := NewValidationHandler(testValidationFunction(mailck.TemporaryFailure, tempErr))
+	req, err := http.NewRequest("GET", "/verify?mail=foo%40example.com", nil)
+	assert.NoError(t, err)
+	resp := httptest.NewRecorder()
+
+	handler.ServeHTTP(resp, req)
+
+	assert.Equal(t, 503, resp.Code)
+	assert.Equal(t, "300", resp.Header().Get("Retry-After"))
+}
+
+func Test_Suggestion(t *testing.T) {
+	handler := NewValidationHandler(func(checkEmail string) (mailck.Result, mailck.Details, error) {
+		return mailck.InvalidDomain, mailck.Details{Suggestion: "foo@gmail.com"}, nil
+	})
+	req, err := http.NewRequest("GET", "/verify?mail=foo%40example.com", nil)
+	assert.NoError(t, err)
+	resp := httptest.NewRecorder()
+
+	handler.ServeHTTP(resp, req)
+
+	assert.Equal(t, 200, resp.Code)
+	assert.Equal(t, "foo@gmail.com", getJson(t, resp)["suggestion"])
+}
+
+func Test_RejectFreeProvider(t *testing.T) {
+	tests := []struct {
+		url          string
+		body         string
+		resultDetail string
+	}{
+		{"/verify?mail=foo%40gmail.com&rejectFreeProvider=true", "", "freeMail"},
+		{"/verify", `{"mail": "foo@gmail.com", "rejectFreeProvider": true}`, "freeMail"},
+		{"/verify?mail=foo%40gmail.com&rejectFreeProvider=false", "", "mailboxChecked"},
+		{"/verify?mail=foo%40gmail.com", "", "mailboxChecked"},
+		{"/verify?mail=foo%40example.com&rejectFreeProvider=true", "", "syntaxChecked"},
+		{"/verify?mail=foo%40gmail.com&rejectFreeProvider=true&verbose=true", "", "freeMail"},
+		// the syntax and the allowlist are checked before the free providers
+		{"/verify?mail=invalid%40%40gmail.com&rejectFreeProvider=true", "", "invalidSyntax"},
+		{"/verify?mail=partner%40gmail.com&rejectFreeProvider=true", "", "mailboxChecked"},
+	}
+	checkFunc := func(checkEmail string) (mailck.Result, mailck.Details, error) {
+		return mailck.Valid, mailck.Details{}, nil
+	}
+	allowlist, err := mailck.NewAddressList("partner@gmail.com")
+	assert.NoError(t, err)
+	checker := mailck.NewChecker(mailck.WithChecks(mailck.SyntaxCheck|mailck.DisposableCheck), mailck.WithAllowlist(allowlist))
+	optionsFunc := func(options CheckOptions) (MailValidationFunction, MailReportFunction) {
+		return checkFunctions(checkerWithOptions(checker, options))
+	}
+	for _, test := range tests {
+		t.Run(test.url+test.body, func(t *testing.T) {
+			method := "GET"
+			if test.body != "" {
+				method = "POST"
+			}
+			req, err := http.NewRequest(method, test.url, strings.NewReader(test.body))
+			assert.NoError(t, err)
+			if test.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			resp := httptest.NewRecorder()
+
+			NewValidationHandler(checkFunc).WithOptionsFunction(optionsFunc).ServeHTTP(resp, req)
+
+			assert.Equal(t, 200, resp.Code)
+			assert.Equal(t, test.resultDetail, getJson(t, resp)["resultDetail"])
+		})
+	}
+
+	req, err := http.NewRequest("GET", "/verify?mail=foo%40gmail.com&rejectFreeProvider=maybe", nil)
+	assert.NoError(t, err)
+	resp := httptest.NewRecorder()
+	NewValidationHandler(checkFunc).WithOptionsFunction(optionsFunc).ServeHTTP(resp, req)
+	assert.Equal(t, 400, resp.Code)
+
+	req, err = http.NewRequest("GET", "/verify?mail=foo%40gmail.com&rejectFreeProvider=true", nil)
+	assert.NoError(t, err)
+	resp = httptest.NewRecorder()
+	NewValidationHandler(checkFunc).ServeHTTP(resp, req)
+	assert.Equal(t, 400, resp.Code)
+	assert.Equal(t, "parameter not supported: rejectFreeProvider", getJson(t, resp)["message"])
+}
+
+func Test_Level(t *testing.T) {
+	tests := []struct {
+		url          string
+		body         string
+		level        string
+		resultDetail string
+	}{
+		{"/verify?mail=foo%40example.com&level=syntax", "", "syntax", "syntaxChecked"},
+		{"/verify", `{"mail": "foo@example.com", "level": "domain"}`, "domain", "domainChecked"},
+		{"/verify?mail=foo%40example.com&verbose=true&level=domain", "", "domain", "domainChecked"},
+		{"/verify?mail=foo%40unknown.example&level=domain", "", "domain", "invalidDomain"},
+		{"/verify?mail=foo%40example.com", "", "mailbox", "mailboxChecked"},
+	}
+	checkFunc := func(checkEmail string) (mailck.Result, mailck.Details, error) {
+		return mailck.Valid, mailck.Details{Level: mailck.MailboxLevel}, nil
+	}
+	checker := mailck.NewChecker(mailck.WithResolver(mailck.NewStaticResolver().AddMX("example.com", "mx.example.com.")))
+	optionsFunc := func(options CheckOptions) (MailValidationFunction, MailReportFunction) {
+		return checkFunctions(checkerWithOptions(checker, options))
+	}
+	for _, test := range tests {
+		t.Run(test.url+test.body, func(t *testing.T) {
+			method := "GET"
+			if test.body != "" {
+				method = "POST"
+			}
+			req, err := http.NewRequest(method, test.url, strings.NewReader(test.body))
+			assert.NoError(t, err)
+			if test.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			resp := httptest.NewRecorder()
+
+			NewValidationHandler(checkFunc).WithOptionsFunction(optionsFunc).ServeHTTP(resp, req)
+
+			assert.Equal(t, 200, resp.Code)
+			response := getJson(t, resp)
+			assert.Equal(t, test.level, response["level"])
+			assert.Equal(t, test.resultDetail, response["resultDetail"])
+		})
+	}
+
+	req, err := http.NewRequest("GET", "/verify?mail=foo%40example.com&level=full", nil)
+	assert.NoError(t, err)
+	resp := httptest.NewRecorder()
+	NewValidationHandler(checkFunc).WithOptionsFunction(optionsFunc).ServeHTTP(resp, req)
+	assert.Equal(t, 400, resp.Code)
+	assert.Equal(t, "invalid parameter: level", getJson(t, resp)["message"])
+
+	req, err = http.NewRequest("GET", "/verify?mail=foo%40example.com&level=syntax", nil)
+	assert.NoError(t, err)
+	resp = httptest.NewRecorder()
+	NewValidationHandler(checkFunc).ServeHTTP(resp, req)
+	assert.Equal(t, 400, resp.Code)
+	assert.Equal(t, "parameter not supported: level", getJson(t, resp)["message"])
+}
+
 func getJson(t *testing.T, resp *httptest.ResponseRecorder) map[string]interface{} {
 	result := map[string]interface{}{}
 	err := json.Unmarshal(resp.Body.Bytes(), &result)
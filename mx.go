@@ -0,0 +1,82 @@
+package mailck
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sort"
+)
+
+// MXFailover describes the walk over the MX list, if not the first MX gave the answer.
+type MXFailover struct {
+	// Host is the MX host, which gave the answer. It is empty, if all hosts failed.
+	Host string `json:"host,omitempty"`
+	// Skipped lists the MX hosts, which were tried before.
+	Skipped []SkippedMX `json:"skipped"`
+}
+
+// SkippedMX is an MX host, which was skipped during the check.
+type SkippedMX struct {
+	Host string `json:"host"`
+	// Reason is the error, which lead to the next MX.
+	Reason string `json:"reason"`
+}
+
+// lookupMX returns the mailservers of the domain sorted by preference.
+// If the domain has no MX records, but an address record, the domain itself
+// is returned as implicit MX according to RFC 5321 section 5.1.
+// For a null MX, errNullMX is returned.
+// The ip of an address literal like [192.0.2.1] is returned as the only mailserver.
+func (c *Checker) lookupMX(ctx context.Context, domain string) (mxList []*net.MX, implicit bool, err error) {
+	if ip := literalIP(domain); ip != nil {
+		// the mailserver of an address literal is known without lookup
+		return []*net.MX{{Host: ip.String()}}, false, nil
+	}
+
+	lookupCtx, cancel := withTimeout(ctx, c.timeouts.Lookup)
+	defer cancel()
+
+	mxList, err = c.resolver.LookupMX(lookupCtx, domain)
+	if err != nil && !isNotFound(err) {
+		return nil, false, err
+	}
+	if len(mxList) > 0 {
+		// work on a copy, because the resolver may return shared records
+		mxList = withoutNullMX(mxList)
+		if len(mxList) == 0 {
+			return nil, false, errNullMX
+		}
+		sort.SliceStable(mxList, func(i, j int) bool {
+			return mxList[i].Pref < mxList[j].Pref
+		})
+		return mxList, false, nil
+	}
+
+	addrs, err := c.resolver.LookupHost(lookupCtx, domain)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(addrs) == 0 {
+		return nil, false, nil
+	}
+	return []*net.MX{{Host: domain, Pref: 0}}, true, nil
+}
+
+// errNullMX is returned for domains, which declare that they accept no mail by a null MX (RFC 7505).
+var errNullMX = errors.New("domain accepts no mail (null MX)")
+
+// withoutNullMX returns a copy of the list without null MX records.
+func withoutNullMX(mxList []*net.MX) []*net.MX {
+	result := make([]*net.MX, 0, len(mxList))
+	for _, mx := range mxList {
+		if mx.Host != "." && mx.Host != "" {
+			result = append(result, mx)
+		}
+	}
+	return result
+}
+
+func isNotFound(err error) bool {
+	dnsErr, ok := err.(*net.DNSError)
+	return ok && dnsErr.IsNotFound
+}
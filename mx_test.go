@@ -0,0 +1,135 @@
+package mailck
+
+import (
+	"context"
+	"errors"
+	"github.com/siebenmann/smtpd"
+	"github.com/stretchr/testify/assert"
+	"net"
+	"testing"
+)
+
+func TestChecker_lookupMX(t *testing.T) {
+	r := NewStaticResolver().
+		AddMX("example.com", "mx1.example.com.", "mx2.example.com.").
+		AddHost("web.example.com", "192.0.2.1").
+		AddHost("empty.example.com").
+		ServFail("broken.example.com")
+	c := NewChecker(WithResolver(r))
+	ctx := context.Background()
+
+	mxList, implicit, err := c.lookupMX(ctx, "example.com")
+	assert.NoError(t, err)
+	assert.False(t, implicit)
+	assert.Equal(t, []*net.MX{{Host: "mx1.example.com.", Pref: 10}, {Host: "mx2.example.com.", Pref: 20}}, mxList)
+
+	mxList, implicit, err = c.lookupMX(ctx, "web.example.com")
+	assert.NoError(t, err)
+	assert.True(t, implicit)
+	assert.Equal(t, []*net.MX{{Host: "web.example.com", Pref: 0}}, mxList)
+
+	mxList, implicit, err = c.lookupMX(ctx, "empty.example.com")
+	assert.NoError(t, err)
+	assert.False(t, implicit)
+	assert.Empty(t, mxList)
+
+	_, _, err = c.lookupMX(ctx, "unknown.example.com")
+	assert.True(t, isNotFound(err))
+
+	_, _, err = c.lookupMX(ctx, "broken.example.com")
+	assert.Error(t, err)
+	assert.False(t, isNotFound(err))
+}
+
+func TestChecker_lookupMX_NullMX(t *testing.T) {
+	r := NewStaticResolver().
+		AddMX("nullmx.example.com", ".").
+		AddMX("mixed.example.com", ".", "mx.example.com.")
+	c := NewChecker(WithResolver(r), WithDialer(failingDialer{t}))
+
+	_, _, err := c.lookupMX(context.Background(), "nullmx.example.com")
+	assert.Equal(t, errNullMX, err)
+
+	mxList, _, err := c.lookupMX(context.Background(), "mixed.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, []*net.MX{{Host: "mx.example.com.", Pref: 20}}, mxList)
+
+	result, err := c.CheckMailbox("foo@nullmx.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, DomainAcceptsNoMail, result)
+	assertResultState(t, result, InvalidState)
+
+	result, err = c.CheckMailboxWithContext(context.Background(), "foo@nullmx.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, DomainAcceptsNoMail, result)
+}
+
+// failingDialer fails the test, if a connection is made
+type failingDialer struct {
+	t *testing.T
+}
+
+func (d failingDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	d.t.Errorf("unexpected connection to %v", address)
+	return nil, errors.New("no connection allowed")
+}
+
+func TestChecker_lookupMX_SortsByPreference(t *testing.T) {
+	r := NewStaticResolver()
+	r.mx["example.com"] = []*net.MX{{Host: "b.", Pref: 20}, {Host: "a.", Pref: 10}}
+	mxList, _, err := NewChecker(WithResolver(r)).lookupMX(context.Background(), "example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "a.", mxList[0].Host)
+}
+
+func TestChecker_CheckMailbox_ImplicitMX(t *testing.T) {
+	dummyServer := NewDummySMTPServer("localhost:2525", smtpd.NOOP, false, 0)
+	defer dummyServer.Close()
+
+	r := NewStaticResolver().AddHost("localhost", "127.0.0.1")
+	c := NewChecker(WithFromEmail("noreply@mancke.net"), WithHeloName("mancke.net"), WithResolver(r), WithSMTPPort(2525))
+
+	rec := newRecorder("foo@localhost")
+	result, err := c.checkMailboxOfReport(context.Background(), &Report{Email: "foo@localhost", checker: c, rec: rec})
+	assert.NoError(t, err)
+	assert.True(t, result.Is(Valid))
+	assert.True(t, rec.report.ImplicitMX)
+
+	result, err = c.CheckMailbox("foo@unknown.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, InvalidDomain, result)
+}
+
+func TestChecker_MXFailover(t *testing.T) {
+	dummyServer := NewDummySMTPServer("localhost:2525", smtpd.NOOP, false, 0)
+	defer dummyServer.Close()
+
+	// nothing listens at mx1, so the check fails over to mx2
+	r := NewStaticResolver().
+		AddMX("example.com", "mx1.example.com.", "mx2.example.com.").
+		AddMX("single.example.com", "mx1.example.com.").
+		AddHost("mx1.example.com", "127.0.0.2").
+		AddHost("mx2.example.com", "127.0.0.1")
+	c := NewChecker(WithFromEmail("noreply@mancke.net"), WithHeloName("mancke.net"), WithResolver(r), WithSMTPPort(2525))
+
+	report, err := c.CheckWithReport(context.Background(), "foo@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, Valid, report.Result)
+	assert.Equal(t, "mx2.example.com.", report.Host)
+	if assert.NotNil(t, report.MXFailover) {
+		assert.Equal(t, "mx2.example.com.", report.MXFailover.Host)
+		if assert.Len(t, report.MXFailover.Skipped, 1) {
+			assert.Equal(t, "mx1.example.com.", report.MXFailover.Skipped[0].Host)
+			assert.NotEmpty(t, report.MXFailover.Skipped[0].Reason)
+		}
+	}
+
+	// a single unreachable MX is recorded as well
+	report, err = c.CheckWithReport(context.Background(), "foo@single.example.com")
+	assert.Error(t, err)
+	assert.Equal(t, NetworkError, report.Result)
+	if assert.NotNil(t, report.MXFailover) {
+		assert.Equal(t, "", report.MXFailover.Host)
+		assert.Len(t, report.MXFailover.Skipped, 1)
+	}
+}
@@ -0,0 +1,248 @@
+package mailck
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// Stage is a step of a check, e.g. the syntax check or a lookup in an internal user database.
+// A stage either decides the result of the check or lets the pipeline continue with the next stage.
+// The address is parsed with the StrictProfile, if possible. Otherwise, it is split at the last @.
+type Stage interface {
+	Run(ctx context.Context, address *Address, report *Report) (Decision, error)
+}
+
+// StageFunc is an adapter to use a function as Stage.
+type StageFunc func(ctx context.Context, address *Address, report *Report) (Decision, error)
+
+// Run calls f.
+func (f StageFunc) Run(ctx context.Context, address *Address, report *Report) (Decision, error) {
+	return f(ctx, address, report)
+}
+
+// Decision is the outcome of a stage.
+type Decision struct {
+	// Result is the result of the check, if Decided is true.
+	Result Result
+	// Decided stops the pipeline with the Result.
+	Decided bool
+}
+
+// Continue lets the pipeline continue with the next stage.
+var Continue = Decision{}
+
+// Decide stops the pipeline with the result.
+func Decide(result Result) Decision {
+	return Decision{Result: result, Decided: true}
+}
+
+// Report is shared by the stages of a single check.
+type Report struct {
+	// Email is the checked address as passed to the check.
+	Email string
+	// MXList contains the mailservers of the domain, after they were looked up by the DomainStage.
+	MXList []*net.MX
+	// ImplicitMX is true, if the domain has no MX records and the domain itself is used as MX.
+	ImplicitMX bool
+	// Level is the deepest level, which the check reached so far.
+	// A stage sets it, when its checks gave a definite answer, e.g. the MailboxLevel,
+	// if the mailserver accepted or rejected the mailbox, but not for a timeout.
+	Level Level
+
+	checker *Checker
+	rec     *recorder
+}
+
+// The names of the built-in stages in the order of the default pipeline.
+const (
+	// SyntaxStage checks the syntax, if the SyntaxCheck is enabled.
+	SyntaxStage = "syntax"
+	// DenylistStage decides Denylisted for addresses of the WithDenylist option.
+	DenylistStage = "denylist"
+	// AllowlistStage decides Valid for addresses of the WithAllowlist option.
+	AllowlistStage = "allowlist"
+	// DisposableStage checks the disposable domains, if the DisposableCheck is enabled.
+	DisposableStage = "disposable"
+	// RoleStage rejects role based addresses, if enabled by WithRoleRejection.
+	RoleStage = "role"
+	// FreeProviderStage rejects addresses of free mail providers, if enabled by WithFreeProviderRejection.
+	FreeProviderStage = "freeProvider"
+	// DomainStage looks up the MX records, if the level is at least the DomainLevel,
+	// and checks them for disposable mail services, if enabled by WithDisposableMXCheck.
+	DomainStage = "domain"
+	// MailboxStage checks the mailbox at the mailserver, if the MailboxCheck is enabled and the level is the MailboxLevel.
+	MailboxStage = "mailbox"
+)
+
+// Pipeline is an ordered list of named stages.
+// The methods modifying the pipeline record the first error, e.g. an unknown stage name,
+// which is returned by Err.
+type Pipeline struct {
+	names  []string
+	stages []Stage
+	err    error
+}
+
+// NewPipeline returns a pipeline with the built-in stages in their default order.
+func NewPipeline() *Pipeline {
+	return (&Pipeline{}).
+		Append(SyntaxStage, builtinStage(syntaxStage)).
+		Append(DenylistStage, builtinStage(denylistStage)).
+		Append(AllowlistStage, builtinStage(allowlistStage)).
+		Append(DisposableStage, builtinStage(disposableStage)).
+		Append(RoleStage, builtinStage(roleStage)).
+		Append(FreeProviderStage, builtinStage(freeProviderStage)).
+		Append(DomainStage, builtinStage(domainStage)).
+		Append(MailboxStage, builtinStage(mailboxStage))
+}
+
+// Names returns the names of the stages in their order.
+func (p *Pipeline) Names() []string {
+	return append([]string(nil), p.names...)
+}
+
+// Err returns the first error of a modification of the pipeline.
+func (p *Pipeline) Err() error {
+	return p.err
+}
+
+// Append adds a stage at the end of the pipeline.
+func (p *Pipeline) Append(name string, stage Stage) *Pipeline {
+	return p.insert(len(p.names), name, stage)
+}
+
+// InsertBefore adds a stage before the stage with the name existing.
+func (p *Pipeline) InsertBefore(existing, name string, stage Stage) *Pipeline {
+	if i, ok := p.index(existing); ok {
+		p.insert(i, name, stage)
+	}
+	return p
+}
+
+// InsertAfter adds a stage after the stage with the name existing.
+func (p *Pipeline) InsertAfter(existing, name string, stage Stage) *Pipeline {
+	if i, ok := p.index(existing); ok {
+		p.insert(i+1, name, stage)
+	}
+	return p
+}
+
+// Replace replaces the stage with the name, e.g. to wrap a built-in stage.
+func (p *Pipeline) Replace(name string, stage Stage) *Pipeline {
+	if i, ok := p.index(name); ok {
+		p.stages[i] = stage
+	}
+	return p
+}
+
+// Remove removes the stages with the names.
+func (p *Pipeline) Remove(names ...string) *Pipeline {
+	for _, name := range names {
+		if i, ok := p.index(name); ok {
+			p.names = append(p.names[:i], p.names[i+1:]...)
+			p.stages = append(p.stages[:i], p.stages[i+1:]...)
+		}
+	}
+	return p
+}
+
+// Reorder arranges the stages in the order of the names. Stages, which are not named, are removed.
+func (p *Pipeline) Reorder(names ...string) *Pipeline {
+	stages := make([]Stage, 0, len(names))
+	seen := map[string]bool{}
+	for _, name := range names {
+		if seen[name] {
+			p.fail(fmt.Errorf("duplicate stage %q in pipeline", name))
+			return p
+		}
+		seen[name] = true
+		i, ok := p.index(name)
+		if !ok {
+			return p
+		}
+		stages = append(stages, p.stages[i])
+	}
+	p.names = append([]string(nil), names...)
+	p.stages = stages
+	return p
+}
+
+func (p *Pipeline) insert(i int, name string, stage Stage) *Pipeline {
+	if _, exists := p.find(name); exists {
+		p.fail(fmt.Errorf("duplicate stage %q in pipeline", name))
+		return p
+	}
+	if stage == nil {
+		p.fail(fmt.Errorf("stage %q is nil", name))
+		return p
+	}
+	p.names = append(p.names[:i], append([]string{name}, p.names[i:]...)...)
+	p.stages = append(p.stages[:i], append([]Stage{stage}, p.stages[i:]...)...)
+	return p
+}
+
+// index returns the position of the stage and records an error, if it does not exist.
+func (p *Pipeline) index(name string) (int, bool) {
+	i, ok := p.find(name)
+	if !ok {
+		p.fail(fmt.Errorf("unknown stage %q in pipeline", name))
+	}
+	return i, ok
+}
+
+func (p *Pipeline) find(name string) (int, bool) {
+	for i, n := range p.names {
+		if n == name {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+func (p *Pipeline) fail(err error) {
+	if p.err == nil {
+		p.err = err
+	}
+}
+
+// run performs the stages until one decides the result.
+// Without a decision, the result is Valid and decided is false.
+func (p *Pipeline) run(ctx context.Context, address *Address, report *Report) (result Result, decided bool, err error) {
+	for _, stage := range p.stages {
+		decision, err := stage.Run(ctx, address, report)
+		if err != nil && !decision.Decided {
+			return ServiceError, true, err
+		}
+		if decision.Decided {
+			return decision.Result, true, err
+		}
+	}
+	return Valid, false, nil
+}
+
+// builtinStage is a stage, which is configured by the options of the running checker.
+type builtinStage func(ctx context.Context, c *Checker, address *Address, report *Report) (Decision, error)
+
+func (s builtinStage) Run(ctx context.Context, address *Address, report *Report) (Decision, error) {
+	return s(ctx, report.checker, address, report)
+}
+
+// stageAddress parses the address for the stages. Addresses, which are invalid according to
+// the StrictProfile, are split at the last @, so that stages can work on them,
+// if the syntax stage is disabled.
+func stageAddress(checkEmail string) *Address {
+	if address, err := ParseAddress(checkEmail, StrictProfile); err == nil {
+		return address
+	}
+	address := &Address{LocalPart: checkEmail}
+	if at := strings.LastIndex(checkEmail, "@"); at != -1 {
+		address.LocalPart, address.Domain = checkEmail[:at], checkEmail[at+1:]
+	}
+	address.ASCIIDomain = address.Domain
+	if ascii, err := toASCIIDomain(address.Domain); err == nil {
+		address.ASCIIDomain = ascii
+	}
+	return address
+}
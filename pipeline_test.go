@@ -0,0 +1,168 @@
+package mailck
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPipeline_Builder(t *testing.T) {
+	noop := StageFunc(func(ctx context.Context, address *Address, report *Report) (Decision, error) {
+		return Continue, nil
+	})
+
+	p := NewPipeline()
+	assert.Equal(t, []string{"syntax", "denylist", "allowlist", "disposable", "role", "freeProvider", "domain", "mailbox"}, p.Names())
+
+	p.InsertBefore(DisposableStage, "userdb", noop).
+		InsertAfter(MailboxStage, "audit", noop).
+		Append("last", noop).
+		Remove(RoleStage, FreeProviderStage).
+		Replace(DomainStage, noop)
+	assert.NoError(t, p.Err())
+	assert.Equal(t, []string{"syntax", "denylist", "allowlist", "userdb", "disposable", "domain", "mailbox", "audit", "last"}, p.Names())
+
+	p.Reorder(DisposableStage, SyntaxStage, MailboxStage)
+	assert.NoError(t, p.Err())
+	assert.Equal(t, []string{"disposable", "syntax", "mailbox"}, p.Names())
+}
+
+func TestPipeline_BuilderErrors(t *testing.T) {
+	noop := StageFunc(func(ctx context.Context, address *Address, report *Report) (Decision, error) {
+		return Continue, nil
+	})
+	tests := []struct {
+		name   string
+		modify func(p *Pipeline)
+		err    string
+	}{
+		{"duplicate", func(p *Pipeline) { p.Append(SyntaxStage, noop) }, `duplicate stage "syntax" in pipeline`},
+		{"nil stage", func(p *Pipeline) { p.Append("custom", nil) }, `stage "custom" is nil`},
+		{"insert before unknown", func(p *Pipeline) { p.InsertBefore("unknown", "custom", noop) }, `unknown stage "unknown" in pipeline`},
+		{"insert after unknown", func(p *Pipeline) { p.InsertAfter("unknown", "custom", noop) }, `unknown stage "unknown" in pipeline`},
+		{"replace unknown", func(p *Pipeline) { p.Replace("unknown", noop) }, `unknown stage "unknown" in pipeline`},
+		{"remove unknown", func(p *Pipeline) { p.Remove("unknown") }, `unknown stage "unknown" in pipeline`},
+		{"reorder unknown", func(p *Pipeline) { p.Reorder(SyntaxStage, "unknown") }, `unknown stage "unknown" in pipeline`},
+		{"reorder duplicate", func(p *Pipeline) { p.Reorder(SyntaxStage, MailboxStage, SyntaxStage) }, `duplicate stage "syntax" in pipeline`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := NewPipeline()
+			test.modify(p)
+			assert.EqualError(t, p.Err(), test.err)
+			assert.Len(t, p.Names(), 8, "the pipeline is unchanged")
+
+			c := NewChecker(WithPipeline(test.modify))
+			assert.EqualError(t, c.Err(), test.err)
+			result, err := c.Check("foo@example.com")
+			assert.Equal(t, ServiceError, result)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestChecker_CustomStage(t *testing.T) {
+	knownUsers := map[string]bool{"known@example.com": true}
+	var seen *Address
+	userDB := StageFunc(func(ctx context.Context, address *Address, report *Report) (Decision, error) {
+		seen = address
+		if knownUsers[report.Email] {
+			return Decide(Valid), nil
+		}
+		return Continue, nil
+	})
+
+	// no MX records: the mailbox stage would decide InvalidDomain
+	checker := NewChecker(
+		WithResolver(NewStaticResolver()),
+		WithPipeline(func(p *Pipeline) {
+			p.InsertBefore(DomainStage, "userdb", userDB)
+		}),
+	)
+	assert.NoError(t, checker.Err())
+
+	report, err := checker.CheckWithReport(context.Background(), "known@example.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(Valid))
+	assert.Equal(t, SyntaxLevel, report.Level)
+	assert.Equal(t, &Address{LocalPart: "known", Domain: "example.com", ASCIIDomain: "example.com"}, seen)
+
+	report, err = checker.CheckWithReport(context.Background(), "unknown@example.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(InvalidDomain))
+	assert.Equal(t, DomainLevel, report.Level)
+
+	// the stages before the custom stage decide first
+	report, err = checker.CheckWithReport(context.Background(), "known@mailinator.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(Disposable))
+}
+
+func TestChecker_StageErrors(t *testing.T) {
+	failing := StageFunc(func(ctx context.Context, address *Address, report *Report) (Decision, error) {
+		return Continue, errors.New("database down")
+	})
+	checker := NewChecker(WithPipeline(func(p *Pipeline) {
+		p.Append("failing", failing).Reorder("failing", MailboxStage)
+	}))
+	result, err := checker.Check("foo@example.com")
+	assert.EqualError(t, err, "database down")
+	assert.Equal(t, ServiceError, result)
+
+	deciding := StageFunc(func(ctx context.Context, address *Address, report *Report) (Decision, error) {
+		return Decide(TemporaryFailure), errors.New("try again")
+	})
+	checker = NewChecker(WithPipeline(func(p *Pipeline) {
+		p.InsertBefore(SyntaxStage, "deciding", deciding)
+	}))
+	result, err = checker.Check("foo@example.com")
+	assert.EqualError(t, err, "try again")
+	assert.Equal(t, TemporaryFailure, result)
+}
+
+func TestChecker_ReorderedPipeline(t *testing.T) {
+	// without the syntax stage, the stages get invalid addresses as well
+	var seen *Address
+	checker := NewChecker(WithPipeline(func(p *Pipeline) {
+		p.Reorder(DisposableStage).Append("record", StageFunc(func(ctx context.Context, address *Address, report *Report) (Decision, error) {
+			seen = address
+			return Continue, nil
+		}))
+	}))
+
+	result, err := checker.Check("foo bar@mailinator.com")
+	assert.NoError(t, err)
+	assert.True(t, result.Is(Disposable))
+
+	result, err = checker.Check("foo bar@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, SyntaxChecked, result)
+	assert.Equal(t, &Address{LocalPart: "foo bar", Domain: "example.com", ASCIIDomain: "example.com"}, seen)
+
+	result, err = checker.Check("xxx")
+	assert.NoError(t, err)
+	assert.Equal(t, SyntaxChecked, result)
+	assert.Equal(t, &Address{LocalPart: "xxx"}, seen)
+}
+
+func TestChecker_StageReportMX(t *testing.T) {
+	resolver := NewStaticResolver().AddMX("example.com", "mx.example.com.")
+	var mxHosts []string
+	checker := NewChecker(
+		WithResolver(resolver),
+		WithPipeline(func(p *Pipeline) {
+			p.InsertAfter(DomainStage, "mxHosts", StageFunc(func(ctx context.Context, address *Address, report *Report) (Decision, error) {
+				for _, mx := range report.MXList {
+					mxHosts = append(mxHosts, mx.Host)
+				}
+				return Decide(Valid), nil
+			}))
+		}),
+	)
+	report, err := checker.CheckWithReport(context.Background(), "foo@example.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(Valid))
+	assert.Equal(t, DomainLevel, report.Level)
+	assert.Equal(t, []string{"mx.example.com."}, mxHosts)
+}
@@ -0,0 +1,155 @@
+package mailck
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// EnhancedCode is an enhanced mail system status code according to RFC 3463, e.g. 5.1.1.
+// The zero value means, that the reply contained no enhanced code.
+type EnhancedCode struct {
+	Class   int
+	Subject int
+	Detail  int
+}
+
+func (e EnhancedCode) String() string {
+	if e.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%d.%d.%d", e.Class, e.Subject, e.Detail)
+}
+
+// IsZero returns true, if no enhanced code is set.
+func (e EnhancedCode) IsZero() bool {
+	return e.Class == 0
+}
+
+// Reply is a parsed SMTP reply.
+type Reply struct {
+	// Code is the basic reply code, e.g. 550.
+	Code int
+	// Enhanced is the enhanced status code, if the reply text started with one.
+	Enhanced EnhancedCode
+	// Text is the reply text without the enhanced status code.
+	Text string
+}
+
+var enhancedCodeRexp = regexp.MustCompile(`^([245])\.(\d{1,3})\.(\d{1,3})(?:\s+|$)`)
+
+// ParseReply parses an SMTP reply code and text, as returned by net/textproto.
+// Continuation lines of multiline replies are joined with a newline.
+func ParseReply(code int, message string) Reply {
+	r := Reply{Code: code, Text: strings.TrimSpace(message)}
+	if match := enhancedCodeRexp.FindStringSubmatch(r.Text); match != nil {
+		r.Enhanced.Class, _ = strconv.Atoi(match[1])
+		r.Enhanced.Subject, _ = strconv.Atoi(match[2])
+		r.Enhanced.Detail, _ = strconv.Atoi(match[3])
+		r.Text = r.Text[len(match[0]):]
+	}
+	// remove the enhanced codes of continuation lines, too
+	lines := strings.Split(r.Text, "\n")
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if i > 0 {
+			if match := enhancedCodeRexp.FindString(line); match != "" {
+				line = line[len(match):]
+			}
+		}
+		lines[i] = line
+	}
+	r.Text = strings.Join(lines, "\n")
+	return r
+}
+
+func (r Reply) String() string {
+	if r.Enhanced.IsZero() {
+		return fmt.Sprintf("%03d %s", r.Code, r.Text)
+	}
+	return fmt.Sprintf("%03d %v %s", r.Code, r.Enhanced, r.Text)
+}
+
+// ClassifyReply maps the reply to a RCPT TO command onto a Result.
+// It can also be used to classify the replies in bounce messages.
+func ClassifyReply(code int, message string) Result {
+	return ParseReply(code, message).Result()
+}
+
+var (
+	mailboxFullRexp     = regexp.MustCompile(`(?i)(mailbox|inbox|account|user|disk|storage)\b.{0,20}\b(full|over ?quota)|quota exceeded|exceeded storage|insufficient storage`)
+	mailboxDisabledRexp = regexp.MustCompile(`(?i)(mailbox|account|user|recipient)\b.{0,20}\b(disabled|inactive|suspended|deactivated|locked)`)
+)
+
+// Result returns the classification of the reply to a RCPT TO command.
+func (r Reply) Result() Result {
+	if r.Code/100 == 2 {
+		return Valid
+	}
+
+	if result, decisive := r.enhancedResult(); decisive {
+		return result
+	}
+
+	if r.Code/100 == 4 {
+		if mailboxFullRexp.MatchString(r.Text) {
+			return MailboxFull
+		}
+		return TemporaryFailure
+	}
+
+	switch r.Code {
+	case 550, 551:
+		switch {
+		case mailboxFullRexp.MatchString(r.Text):
+			return MailboxFull
+		case mailboxDisabledRexp.MatchString(r.Text):
+			return MailboxDisabled
+		case hasBlockHint(r.Text):
+			return SenderBlocked
+		}
+		return MailboxUnavailable
+	case 552:
+		return MailboxFull
+	case 553:
+		return InvalidSyntax
+	case 554:
+		if hasBlockHint(r.Text) {
+			return SenderBlocked
+		}
+		return PolicyRejection
+	}
+	return MailserverError
+}
+
+// enhancedResult classifies the reply by the enhanced status code.
+// It returns false, if the enhanced code is missing or not specific enough.
+func (r Reply) enhancedResult() (result Result, decisive bool) {
+	e := r.Enhanced
+	if e.Class != 4 && e.Class != 5 {
+		return Result{}, false
+	}
+	switch {
+	case e.Subject == 2 && e.Detail == 2:
+		return MailboxFull, true
+	case e.Class == 4 && e.Subject == 7 && hasBlockHint(r.Text):
+		return SenderBlocked, true
+	case e.Class == 4:
+		return TemporaryFailure, true
+	case e.Subject == 1 && (e.Detail == 1 || e.Detail == 6):
+		return MailboxUnavailable, true
+	case e.Subject == 1 && e.Detail == 2:
+		return InvalidDomain, true
+	case e.Subject == 1 && e.Detail == 3:
+		return InvalidSyntax, true
+	case e.Subject == 2 && e.Detail == 1:
+		return MailboxDisabled, true
+	case e.Subject == 7:
+		if hasBlockHint(r.Text) {
+			return SenderBlocked, true
+		}
+		return PolicyRejection, true
+	}
+	return Result{}, false
+}
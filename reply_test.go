@@ -0,0 +1,74 @@
+package mailck
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseReply(t *testing.T) {
+	tests := []struct {
+		code     int
+		message  string
+		expected Reply
+	}{
+		{250, "2.1.5 Ok", Reply{250, EnhancedCode{2, 1, 5}, "Ok"}},
+		{550, "5.1.1 <foo@example.com>: Recipient address rejected", Reply{550, EnhancedCode{5, 1, 1}, "<foo@example.com>: Recipient address rejected"}},
+		{550, "No such user here", Reply{550, EnhancedCode{}, "No such user here"}},
+		{552, "5.2.2 The email account that you tried to reach is over quota.\n5.2.2 Please direct the recipient to",
+			Reply{552, EnhancedCode{5, 2, 2}, "The email account that you tried to reach is over quota.\nPlease direct the recipient to"}},
+		{550, "5.10.100 strange", Reply{550, EnhancedCode{5, 10, 100}, "strange"}},
+		{550, "5.1.1", Reply{550, EnhancedCode{5, 1, 1}, ""}},
+		{550, "5.1.1x no code", Reply{550, EnhancedCode{}, "5.1.1x no code"}},
+	}
+	for _, test := range tests {
+		t.Run(test.message, func(t *testing.T) {
+			assert.Equal(t, test.expected, ParseReply(test.code, test.message))
+		})
+	}
+}
+
+func TestReply_String(t *testing.T) {
+	assert.Equal(t, "550 5.1.1 unknown", ParseReply(550, "5.1.1 unknown").String())
+	assert.Equal(t, "550 unknown", ParseReply(550, "unknown").String())
+	assert.Equal(t, "", EnhancedCode{}.String())
+}
+
+func TestClassifyReply(t *testing.T) {
+	tests := []struct {
+		code     int
+		message  string
+		expected Result
+	}{
+		{250, "2.1.5 Ok", Valid},
+		{251, "User not local; will forward", Valid},
+		{550, "5.1.1 The email account that you tried to reach does not exist.", MailboxUnavailable},
+		{550, "Requested action not taken: mailbox unavailable", MailboxUnavailable},
+		{551, "User not local; please try <forward@example.com>", MailboxUnavailable},
+		{550, "5.1.2 Bad destination system address", InvalidDomain},
+		{553, "Requested action not taken: mailbox name not allowed", InvalidSyntax},
+		{501, "5.1.3 Bad recipient address syntax", InvalidSyntax},
+		{552, "Requested mail action aborted: exceeded storage allocation", MailboxFull},
+		{552, "5.2.2 The email account that you tried to reach is over quota.", MailboxFull},
+		{452, "4.2.2 The email account that you tried to reach is over quota.", MailboxFull},
+		{550, "Mailbox is full", MailboxFull},
+		{550, "5.2.1 The email account that you tried to reach is disabled.", MailboxDisabled},
+		{550, "Account suspended", MailboxDisabled},
+		{550, "5.7.1 Relaying denied", PolicyRejection},
+		{550, "5.7.1 Access denied", PolicyRejection},
+		{554, "Message blocked due to its content", PolicyRejection},
+		{554, "Transaction failed", PolicyRejection},
+		{550, "5.7.1 Service unavailable; client host [192.0.2.1] blocked using zen.spamhaus.org", SenderBlocked},
+		{554, "Your IP is blacklisted", SenderBlocked},
+		{450, "4.7.1 Greylisted, please try again later", TemporaryFailure},
+		{450, "4.7.1 Client host rejected: blocked using zen.spamhaus.org", SenderBlocked},
+		{451, "Requested action aborted: local error in processing", TemporaryFailure},
+		{421, "Service not available, closing transmission channel", TemporaryFailure},
+		{500, "Syntax error, command unrecognized", MailserverError},
+		{550, "5.0.0 unknown", MailboxUnavailable},
+	}
+	for _, test := range tests {
+		t.Run(test.message, func(t *testing.T) {
+			assert.Equal(t, test.expected, ClassifyReply(test.code, test.message))
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package mailck
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Phase is a step of the email check.
+type Phase string
+
+const (
+	PhaseLookup   Phase = "lookup"
+	PhaseConnect  Phase = "connect"
+	PhaseGreeting Phase = "greeting"
+	PhaseHelo     Phase = "helo"
+	PhaseStartTLS Phase = "starttls"
+	PhaseMailFrom Phase = "mailFrom"
+	PhaseRcptTo   Phase = "rcptTo"
+)
+
+// CheckReport contains the result of a check together with the details of the check.
+// The fields of the result are embedded, so that the JSON form is a superset of the result.
+type CheckReport struct {
+	Result
+	Details
+	// Email is the checked address.
+	Email string `json:"email"`
+	// MXRecords are the mailservers found for the domain.
+	MXRecords []MXRecord `json:"mxRecords,omitempty"`
+	// Host is the mailserver, which gave the final answer.
+	Host string `json:"host,omitempty"`
+	// Transcript contains all SMTP commands and replies.
+	Transcript []Exchange `json:"transcript,omitempty"`
+	// Timings contains the duration of the single phases.
+	Timings []Timing `json:"timings,omitempty"`
+	// Error is the text of the error returned by the check.
+	Error string `json:"error,omitempty"`
+}
+
+// MXRecord is an MX record of the checked domain.
+type MXRecord struct {
+	Host string `json:"host"`
+	Pref uint16 `json:"pref"`
+}
+
+// Exchange is a command sent to a mailserver together with the reply.
+type Exchange struct {
+	// Host is the mailserver.
+	Host string `json:"host"`
+	// Command is the command line. It is empty for the greeting of the mailserver.
+	Command string `json:"command,omitempty"`
+	// Code is the reply code, or zero, if no reply was received.
+	Code int `json:"code"`
+	// Message is the reply text. Lines of multiline replies are separated by newlines.
+	Message string `json:"message"`
+}
+
+// String returns the exchange in the form of an SMTP log.
+func (e Exchange) String() string {
+	b := &strings.Builder{}
+	if e.Command != "" {
+		fmt.Fprintf(b, "C: %s\n", e.Command)
+	}
+	lines := strings.Split(e.Message, "\n")
+	for i, line := range lines {
+		separator := "-"
+		if i == len(lines)-1 {
+			separator = " "
+		}
+		fmt.Fprintf(b, "S: %03d%s%s\n", e.Code, separator, line)
+	}
+	return b.String()
+}
+
+// Timing is the duration of a phase of the check.
+type Timing struct {
+	Phase Phase `json:"phase"`
+	// Host is the mailserver, if the phase belongs to the SMTP conversation.
+	Host     string        `json:"host,omitempty"`
+	Duration time.Duration `json:"-"`
+}
+
+// MarshalJSON writes the duration in milliseconds.
+func (t Timing) MarshalJSON() ([]byte, error) {
+	type timing Timing
+	return json.Marshal(struct {
+		timing
+		Milliseconds float64 `json:"ms"`
+	}{timing(t), float64(t.Duration) / float64(time.Millisecond)})
+}
+
+// recorder collects the details of a check.
+// It is safe for use by the goroutine of the SMTP conversation.
+type recorder struct {
+	mutex  sync.Mutex
+	report CheckReport
+}
+
+func newRecorder(checkEmail string) *recorder {
+	return &recorder{report: CheckReport{Email: checkEmail}}
+}
+
+func (r *recorder) exchange(host, command string, code int, msg string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.report.Transcript = append(r.report.Transcript, Exchange{Host: host, Command: command, Code: code, Message: msg})
+}
+
+// timing records the duration of the phase since start.
+func (r *recorder) timing(phase Phase, host string, start time.Time) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.report.Timings = append(r.report.Timings, Timing{Phase: phase, Host: host, Duration: time.Since(start)})
+}
+
+func (r *recorder) mxRecords(mxList []*net.MX) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.report.MXRecords = r.report.MXRecords[:0]
+	for _, mx := range mxList {
+		r.report.MXRecords = append(r.report.MXRecords, MXRecord{Host: mx.Host, Pref: mx.Pref})
+	}
+}
+
+func (r *recorder) host(host string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.report.Host = host
+}
+
+// details changes the details of the report by update.
+func (r *recorder) details(update func(d *Details)) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	update(&r.report.Details)
+}
+
+// finish returns a copy of the report with the result of the check.
+func (r *recorder) finish(result Result, err error) *CheckReport {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	report := r.report
+	report.Result = result
+	if err != nil {
+		report.Error = err.Error()
+	}
+	report.MXRecords = append([]MXRecord(nil), report.MXRecords...)
+	report.Transcript = append([]Exchange(nil), report.Transcript...)
+	report.Timings = append([]Timing(nil), report.Timings...)
+	return &report
+}
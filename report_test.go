@@ -0,0 +1,84 @@
+package mailck
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/siebenmann/smtpd"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestChecker_CheckWithReport(t *testing.T) {
+	dummyServer := NewDummySMTPServer("localhost:2525", smtpd.RCPTTO, false, 0)
+	defer dummyServer.Close()
+
+	r := NewStaticResolver().AddMX("bar.de", "localhost").AddHost("localhost", "127.0.0.1")
+	c := NewChecker(WithFromEmail("noreply@mancke.net"), WithResolver(r), WithSMTPPort(2525))
+
+	report, err := c.CheckWithReport(context.Background(), "foo@bar.de")
+	assert.NoError(t, err)
+	assert.True(t, report.Result.Is(MailboxUnavailable))
+	assert.Equal(t, MailboxLevel, report.Level)
+	assert.Equal(t, "foo@bar.de", report.Email)
+	assert.Equal(t, []MXRecord{{Host: "localhost", Pref: 10}}, report.MXRecords)
+	assert.Equal(t, "localhost", report.Host)
+	assert.Empty(t, report.Error)
+
+	if assert.True(t, len(report.Transcript) >= 4) {
+		assert.Equal(t, Exchange{Host: "localhost", Code: 220, Message: report.Transcript[0].Message}, report.Transcript[0])
+		assert.Equal(t, "EHLO mancke.net", report.Transcript[1].Command)
+		assert.Equal(t, 250, report.Transcript[1].Code)
+		assert.Equal(t, "MAIL FROM:<noreply@mancke.net>", report.Transcript[2].Command)
+		assert.Equal(t, 250, report.Transcript[2].Code)
+		assert.Equal(t, "RCPT TO:<foo@bar.de>", report.Transcript[3].Command)
+		assert.Equal(t, 550, report.Transcript[3].Code)
+	}
+
+	var phases []Phase
+	for _, timing := range report.Timings {
+		phases = append(phases, timing.Phase)
+	}
+	assert.Equal(t, []Phase{PhaseLookup, PhaseConnect, PhaseGreeting, PhaseHelo, PhaseMailFrom, PhaseRcptTo}, phases)
+
+	b, err := json.Marshal(report)
+	assert.NoError(t, err)
+	m := map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal(b, &m))
+	assert.Equal(t, "invalid", m["result"])
+	assert.Equal(t, "mailboxUnavailable", m["resultDetail"])
+	assert.Equal(t, "localhost", m["host"])
+	assert.NotEmpty(t, m["transcript"])
+	assert.Contains(t, m["timings"].([]interface{})[0], "ms")
+}
+
+func TestChecker_CheckWithReport_Error(t *testing.T) {
+	r := NewStaticResolver().AddMX("bar.de", "localhost").AddHost("localhost", "127.0.0.1")
+	c := NewChecker(WithFromEmail("noreply@mancke.net"), WithResolver(r), WithSMTPPort(6666))
+
+	report, err := c.CheckWithReport(context.Background(), "foo@bar.de")
+	assert.Error(t, err)
+	assert.True(t, report.Result.Is(NetworkError))
+	assert.Equal(t, DomainLevel, report.Level)
+	assert.Equal(t, err.Error(), report.Error)
+	assert.Empty(t, report.Transcript)
+
+	report, err = c.CheckWithReport(context.Background(), "xxx")
+	assert.NoError(t, err)
+	assert.True(t, report.Result.Is(InvalidSyntax))
+	assert.Empty(t, report.MXRecords)
+}
+
+func TestExchange_String(t *testing.T) {
+	e := Exchange{Host: "mx", Command: "EHLO mancke.net", Code: 250, Message: "mx.example.com\nPIPELINING\nSTARTTLS"}
+	assert.Equal(t, "C: EHLO mancke.net\nS: 250-mx.example.com\nS: 250-PIPELINING\nS: 250 STARTTLS\n", e.String())
+
+	e = Exchange{Host: "mx", Code: 220, Message: "mx.example.com ESMTP"}
+	assert.Equal(t, "S: 220 mx.example.com ESMTP\n", e.String())
+}
+
+func TestTiming_MarshalJSON(t *testing.T) {
+	b, err := json.Marshal(Timing{Phase: PhaseConnect, Host: "mx", Duration: 1500 * time.Microsecond})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"phase": "connect", "host": "mx", "ms": 1.5}`, string(b))
+}
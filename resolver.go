@@ -0,0 +1,138 @@
+package mailck
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+)
+
+// Resolver performs the DNS lookups of a Checker.
+// A *net.Resolver satisfies this interface.
+type Resolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+	LookupHost(ctx context.Context, host string) (addrs []string, err error)
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// StaticResolver is an in-memory Resolver, which answers from scripted records.
+// It is intended for tests, which should not depend on the real DNS.
+// Unknown names are answered with a not found error.
+type StaticResolver struct {
+	mutex  sync.RWMutex
+	mx     map[string][]*net.MX
+	hosts  map[string][]string
+	txt    map[string][]string
+	errors map[string]error
+}
+
+// NewStaticResolver creates an empty StaticResolver.
+func NewStaticResolver() *StaticResolver {
+	return &StaticResolver{
+		mx:     map[string][]*net.MX{},
+		hosts:  map[string][]string{},
+		txt:    map[string][]string{},
+		errors: map[string]error{},
+	}
+}
+
+// AddMX adds MX records for the domain. The preference is taken from the order of the hosts.
+func (r *StaticResolver) AddMX(domain string, hosts ...string) *StaticResolver {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	name := normalizeName(domain)
+	for _, host := range hosts {
+		r.mx[name] = append(r.mx[name], &net.MX{Host: host, Pref: uint16(10 * (len(r.mx[name]) + 1))})
+	}
+	return r
+}
+
+// AddHost adds address records for the host.
+func (r *StaticResolver) AddHost(host string, addrs ...string) *StaticResolver {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	name := normalizeName(host)
+	r.hosts[name] = append(r.hosts[name], addrs...)
+	return r
+}
+
+// AddTXT adds TXT records for the name.
+func (r *StaticResolver) AddTXT(name string, txt ...string) *StaticResolver {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	n := normalizeName(name)
+	r.txt[n] = append(r.txt[n], txt...)
+	return r
+}
+
+// SetError lets all lookups of the name fail with err.
+func (r *StaticResolver) SetError(name string, err error) *StaticResolver {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.errors[normalizeName(name)] = err
+	return r
+}
+
+// ServFail lets all lookups of the name fail like a SERVFAIL answer of the nameserver.
+func (r *StaticResolver) ServFail(name string) *StaticResolver {
+	return r.SetError(name, &net.DNSError{Err: "server misbehaving", Name: name, IsTemporary: true})
+}
+
+// Timeout lets all lookups of the name fail with a timeout.
+func (r *StaticResolver) Timeout(name string) *StaticResolver {
+	return r.SetError(name, &net.DNSError{Err: "i/o timeout", Name: name, IsTimeout: true, IsTemporary: true})
+}
+
+func (r *StaticResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	n := normalizeName(name)
+	if err := r.lookupError(ctx, n); err != nil {
+		return nil, err
+	}
+	if mx, exist := r.mx[n]; exist {
+		return mx, nil
+	}
+	return nil, notFound(name)
+}
+
+func (r *StaticResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	n := normalizeName(host)
+	if err := r.lookupError(ctx, n); err != nil {
+		return nil, err
+	}
+	if addrs, exist := r.hosts[n]; exist {
+		return addrs, nil
+	}
+	return nil, notFound(host)
+}
+
+func (r *StaticResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	n := normalizeName(name)
+	if err := r.lookupError(ctx, n); err != nil {
+		return nil, err
+	}
+	if txt, exist := r.txt[n]; exist {
+		return txt, nil
+	}
+	return nil, notFound(name)
+}
+
+func (r *StaticResolver) lookupError(ctx context.Context, name string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return r.errors[name]
+}
+
+func notFound(name string) error {
+	return &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, "."))
+}
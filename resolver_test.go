@@ -0,0 +1,89 @@
+package mailck
+
+import (
+	"context"
+	"errors"
+	"github.com/siebenmann/smtpd"
+	"github.com/stretchr/testify/assert"
+	"net"
+	"testing"
+)
+
+func TestStaticResolver(t *testing.T) {
+	ctx := context.Background()
+	r := NewStaticResolver().
+		AddMX("example.com", "mx1.example.com.", "mx2.example.com.").
+		AddHost("mx1.example.com", "192.0.2.1").
+		AddTXT("example.com", "v=spf1 -all").
+		ServFail("broken.example.com").
+		Timeout("slow.example.com")
+
+	mx, err := r.LookupMX(ctx, "Example.COM.")
+	assert.NoError(t, err)
+	assert.Equal(t, []*net.MX{{Host: "mx1.example.com.", Pref: 10}, {Host: "mx2.example.com.", Pref: 20}}, mx)
+
+	addrs, err := r.LookupHost(ctx, "mx1.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"192.0.2.1"}, addrs)
+
+	txt, err := r.LookupTXT(ctx, "example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"v=spf1 -all"}, txt)
+
+	_, err = r.LookupMX(ctx, "unknown.example.com")
+	assert.True(t, err.(*net.DNSError).IsNotFound)
+
+	_, err = r.LookupHost(ctx, "broken.example.com")
+	assert.True(t, err.(*net.DNSError).IsTemporary)
+	assert.False(t, err.(*net.DNSError).IsTimeout)
+
+	_, err = r.LookupTXT(ctx, "slow.example.com")
+	assert.True(t, err.(*net.DNSError).Timeout())
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = r.LookupMX(canceled, "example.com")
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestStaticResolver_ImplementsResolver(t *testing.T) {
+	var _ Resolver = NewStaticResolver()
+	var _ Resolver = net.DefaultResolver
+}
+
+func TestChecker_ResolvesMXHostsByResolver(t *testing.T) {
+	dummyServer := NewDummySMTPServer("localhost:2525", smtpd.NOOP, false, 0)
+	defer dummyServer.Close()
+
+	// the MX host exists only in the static resolver
+	r := NewStaticResolver().
+		AddMX("example.com", "mx.static.invalid.").
+		AddHost("mx.static.invalid", "127.0.0.1")
+	c := NewChecker(WithFromEmail("noreply@mancke.net"), WithHeloName("mancke.net"), WithResolver(r), WithSMTPPort(2525))
+
+	report, err := c.CheckWithReport(context.Background(), "foo@example.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Result.Is(Valid))
+	assert.Equal(t, "mx.static.invalid.", report.Host)
+
+	// unknown MX hosts are not looked up by the system resolver
+	r = NewStaticResolver().AddMX("example.com", "localhost.")
+	c = NewChecker(WithFromEmail("noreply@mancke.net"), WithHeloName("mancke.net"), WithResolver(r), WithSMTPPort(2525))
+	result, err := c.CheckMailbox("foo@example.com")
+	assert.True(t, result.Is(NetworkError))
+	var dialErr *DialError
+	if assert.True(t, errors.As(err, &dialErr)) {
+		assert.Equal(t, "localhost.:2525", dialErr.Addr)
+	}
+}
+
+func TestChecker_UsesResolver(t *testing.T) {
+	r := NewStaticResolver().
+		ServFail("broken.example.com").
+		AddMX("example.com", "mx.example.com.")
+	c := NewChecker(WithFromEmail("noreply@example.com"), WithResolver(r))
+
+	result, err := c.CheckMailbox("foo@broken.example.com")
+	assert.Error(t, err)
+	assert.Equal(t, DNSFailure, result)
+}
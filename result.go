@@ -6,6 +6,7 @@ const (
 	ValidState   ResultState = "valid"
 	InvalidState             = "invalid"
 	ErrorState               = "error"
+	RiskyState               = "risky"
 )
 
 func (rs ResultState) String() string {
@@ -13,25 +14,75 @@ func (rs ResultState) String() string {
 }
 
 // Result contains the information about an email check.
+// The results of the checks are the predefined results, so they can be compared with ==.
 type Result struct {
 	Result       ResultState `json:"result"`
 	ResultDetail string      `json:"resultDetail"`
 	Message      string      `json:"message"`
 }
 
+// Details contains additional information about a check, which is reported by CheckWithReport.
+type Details struct {
+	// TLS is only set, if STARTTLS is enabled for the check.
+	TLS *TLSInfo `json:"tls,omitempty"`
+	// ImplicitMX is true, if the domain has no MX records and the mailbox
+	// was checked at the address records of the domain (RFC 5321, section 5.1).
+	ImplicitMX bool `json:"implicitMX,omitempty"`
+	// MXFailover is only set, if at least one MX host was skipped,
+	// e.g. because it was unreachable.
+	MXFailover *MXFailover `json:"mxFailover,omitempty"`
+	// TimeoutPhase is the phase, which timed out, if the result is TimeoutError.
+	TimeoutPhase Phase `json:"timeoutPhase,omitempty"`
+	// SyntaxReason tells, why the address is invalid, if the result is InvalidSyntax.
+	SyntaxReason SyntaxReason `json:"syntaxReason,omitempty"`
+	// Suggestion is a corrected address, if the domain looks like a typo, e.g. foo@gmail.com for foo@gmial.com.
+	Suggestion string `json:"suggestion,omitempty"`
+	// Role is true for role based addresses like info@example.com.
+	Role bool `json:"role,omitempty"`
+	// FreeProvider is true for addresses of free mail providers like gmail.com.
+	FreeProvider bool `json:"freeProvider,omitempty"`
+	// DisposableMatch is the rule, which matched, if the result is Disposable.
+	DisposableMatch *DisposableMatch `json:"disposableMatch,omitempty"`
+	// ListMatch is the entry, which matched, if the result was decided by the allowlist or denylist.
+	ListMatch *ListMatch `json:"listMatch,omitempty"`
+	// Level is the deepest level, which Check achieved, e.g. the DomainLevel,
+	// if the mailserver could not be reached.
+	Level Level `json:"level,omitempty"`
+}
+
 var (
-	Valid              = Result{ValidState, "mailboxChecked", "The email address is valid."}
-	InvalidSyntax      = Result{InvalidState, "invalidSyntax", "The email format is invalid."}
-	InvalidDomain      = Result{InvalidState, "invalidDomain", "The email domain does not exist."}
-	MailboxUnavailable = Result{InvalidState, "mailboxUnavailable", "The email username does not exist."}
-	Disposable         = Result{InvalidState, "disposable", "The email is a throw-away address."}
-	MailserverError    = Result{ErrorState, "mailserverError", "The target mailserver responded with an error."}
-	TimeoutError       = Result{ErrorState, "timeoutError", "The connection with the mailserver timed out."}
-	NetworkError       = Result{ErrorState, "networkError", "The connection to the mailserver could not be made."}
-	ServiceError       = Result{ErrorState, "serviceError", "An internal error occured while checking."}
-	ClientError        = Result{ErrorState, "clientError", "The request was was invalid."}
+	Valid               = Result{Result: ValidState, ResultDetail: "mailboxChecked", Message: "The email address is valid."}
+	DomainChecked       = Result{Result: ValidState, ResultDetail: "domainChecked", Message: "The email domain is valid, the mailbox was not checked."}
+	SyntaxChecked       = Result{Result: ValidState, ResultDetail: "syntaxChecked", Message: "The email address passed the checks without network access, the domain was not checked."}
+	InvalidSyntax       = Result{Result: InvalidState, ResultDetail: "invalidSyntax", Message: "The email format is invalid."}
+	InvalidDomain       = Result{Result: InvalidState, ResultDetail: "invalidDomain", Message: "The email domain does not exist."}
+	DomainAcceptsNoMail = Result{Result: InvalidState, ResultDetail: "domainAcceptsNoMail", Message: "The email domain does not accept mails."}
+	MailboxUnavailable  = Result{Result: InvalidState, ResultDetail: "mailboxUnavailable", Message: "The email username does not exist."}
+	MailboxDisabled     = Result{Result: InvalidState, ResultDetail: "mailboxDisabled", Message: "The mailbox is disabled."}
+	MailboxFull         = Result{Result: RiskyState, ResultDetail: "mailboxFull", Message: "The mailbox exists, but is full."}
+	SMTPUTF8Unsupported = Result{Result: InvalidState, ResultDetail: "smtpUTF8Unsupported", Message: "The mailserver can't receive mails for addresses with international characters."}
+	Disposable          = Result{Result: InvalidState, ResultDetail: "disposable", Message: "The email is a throw-away address."}
+	Denylisted          = Result{Result: InvalidState, ResultDetail: "denylisted", Message: "The email address is on the denylist."}
+	RoleAccount         = Result{Result: InvalidState, ResultDetail: "roleAccount", Message: "The email is a role based address."}
+	FreeMail            = Result{Result: InvalidState, ResultDetail: "freeMail", Message: "The email belongs to a free mail provider."}
+	AcceptAll           = Result{Result: RiskyState, ResultDetail: "acceptAll", Message: "The mailserver accepts all addresses of the domain."}
+	MailserverError     = Result{Result: ErrorState, ResultDetail: "mailserverError", Message: "The target mailserver responded with an error."}
+	TemporaryFailure    = Result{Result: ErrorState, ResultDetail: "tryAgainLater", Message: "The target mailserver asked to try again later."}
+	PolicyRejection     = Result{Result: ErrorState, ResultDetail: "policyRejection", Message: "The target mailserver rejected the check because of its policy."}
+	SenderBlocked       = Result{Result: ErrorState, ResultDetail: "senderBlocked", Message: "The target mailserver blocked the checking server."}
+	TimeoutError        = Result{Result: ErrorState, ResultDetail: "timeoutError", Message: "The connection with the mailserver timed out."}
+	NetworkError        = Result{Result: ErrorState, ResultDetail: "networkError", Message: "The connection to the mailserver could not be made."}
+	DNSFailure          = Result{Result: ErrorState, ResultDetail: "dnsFailure", Message: "The mailservers of the email domain could not be looked up."}
+	TLSError            = Result{Result: ErrorState, ResultDetail: "tlsError", Message: "The TLS connection to the mailserver could not be established."}
+	ServiceError        = Result{Result: ErrorState, ResultDetail: "serviceError", Message: "An internal error occured while checking."}
+	ClientError         = Result{Result: ErrorState, ResultDetail: "clientError", Message: "The request was was invalid."}
 )
 
+// Is returns true, if both results have the same state and detail.
+func (r Result) Is(other Result) bool {
+	return r.Result == other.Result && r.ResultDetail == other.ResultDetail
+}
+
 func (r Result) IsValid() bool {
 	return r.Result == ValidState
 }
@@ -43,3 +94,7 @@ func (r Result) IsInvalid() bool {
 func (r Result) IsError() bool {
 	return r.Result == ErrorState
 }
+
+func (r Result) IsRisky() bool {
+	return r.Result == RiskyState
+}
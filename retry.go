@@ -0,0 +1,113 @@
+package mailck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/textproto"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy configures the retries of temporary failed checks, e.g. caused by greylisting.
+type RetryPolicy struct {
+	// Retries is the maximum number of retries. Zero disables retrying.
+	Retries int
+	// Delay is the wait time before a retry, if the mailserver does not suggest one.
+	Delay time.Duration
+	// MaxDelay limits the wait time suggested by the mailserver, a longer suggestion is shortened to MaxDelay.
+	// Zero means no limit.
+	MaxDelay time.Duration
+}
+
+// WithRetryPolicy enables retries of temporary failed mailbox checks.
+// A retry is only done, if the wait time fits into the deadline of the context.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Checker) {
+		c.retry = policy
+	}
+}
+
+// TemporaryError is returned together with the TemporaryFailure result.
+type TemporaryError struct {
+	// Code is the SMTP reply code, e.g. 450.
+	Code int
+	// Message is the text of the SMTP reply.
+	Message string
+	// RetryAfter is the wait time suggested by the mailserver or zero, if there was no suggestion.
+	RetryAfter time.Duration
+	// Err is the underlying error, usually an *SMTPError.
+	Err error
+}
+
+func (e *TemporaryError) Error() string {
+	return fmt.Sprintf("temporary failure %03d %s", e.Code, e.Message)
+}
+
+func (e *TemporaryError) Unwrap() error {
+	return e.Err
+}
+
+// asTemporaryError returns a TemporaryError, if err is a 4xx SMTP reply.
+func asTemporaryError(err error) (*TemporaryError, bool) {
+	var protoErr *textproto.Error
+	if !errors.As(err, &protoErr) || protoErr.Code/100 != 4 {
+		return nil, false
+	}
+	return &TemporaryError{
+		Code:       protoErr.Code,
+		Message:    protoErr.Msg,
+		RetryAfter: parseRetryAfter(protoErr.Msg),
+		Err:        err,
+	}, true
+}
+
+var retryAfterRexp = regexp.MustCompile(`(?i)(?:retry|try again|wait|greylisted)\D{0,30}?(\d+)\s*(seconds?|secs?|s|minutes?|mins?|m|hours?|h)\b`)
+
+// parseRetryAfter extracts a wait time like "try again in 5 minutes" from a reply text.
+func parseRetryAfter(msg string) time.Duration {
+	match := retryAfterRexp.FindStringSubmatch(msg)
+	if match == nil {
+		return 0
+	}
+	n, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0
+	}
+	switch unit := strings.ToLower(match[2]); {
+	case strings.HasPrefix(unit, "h"):
+		return time.Duration(n) * time.Hour
+	case strings.HasPrefix(unit, "m"):
+		return time.Duration(n) * time.Minute
+	default:
+		return time.Duration(n) * time.Second
+	}
+}
+
+// waitForRetry waits for the next retry and returns false,
+// if no retry should be done within the limits of the policy and the context.
+func (p RetryPolicy) waitForRetry(ctx context.Context, attempt int, tempErr *TemporaryError) bool {
+	if attempt >= p.Retries {
+		return false
+	}
+	wait := p.Delay
+	if tempErr.RetryAfter > 0 {
+		wait = tempErr.RetryAfter
+		if p.MaxDelay > 0 && wait > p.MaxDelay {
+			wait = p.MaxDelay
+		}
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+		return false
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,76 @@
+package mailck
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/textproto"
+	"testing"
+	"time"
+)
+
+func Test_parseRetryAfter(t *testing.T) {
+	tests := []struct {
+		msg      string
+		expected time.Duration
+	}{
+		{"4.7.1 Greylisted, please try again in 300 seconds", 300 * time.Second},
+		{"Greylisting in action, please come back later", 0},
+		{"4.2.0 Try again in 5 minutes", 5 * time.Minute},
+		{"Temporary failure, retry after 2h", 2 * time.Hour},
+		{"Greylisted for 60s", 60 * time.Second},
+		{"Mailbox busy", 0},
+	}
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			assert.Equal(t, test.expected, parseRetryAfter(test.msg))
+		})
+	}
+}
+
+func Test_asTemporaryError(t *testing.T) {
+	protoErr := &textproto.Error{Code: 451, Msg: "4.7.1 Greylisted, try again in 30 seconds"}
+	tempErr, ok := asTemporaryError(protoErr)
+	assert.True(t, ok)
+	assert.Equal(t, &TemporaryError{Code: 451, Message: "4.7.1 Greylisted, try again in 30 seconds", RetryAfter: 30 * time.Second, Err: protoErr}, tempErr)
+
+	tempErr, ok = asTemporaryError(newSMTPError("mx.example.com", PhaseRcptTo, protoErr))
+	assert.True(t, ok)
+	assert.Equal(t, 451, tempErr.Code)
+
+	_, ok = asTemporaryError(&textproto.Error{Code: 550, Msg: "no such user"})
+	assert.False(t, ok)
+
+	_, ok = asTemporaryError(errors.New("some error"))
+	assert.False(t, ok)
+
+	_, ok = asTemporaryError(nil)
+	assert.False(t, ok)
+}
+
+func TestRetryPolicy_waitForRetry(t *testing.T) {
+	background := context.Background()
+	withDeadline, cancel := context.WithTimeout(background, 50*time.Millisecond)
+	defer cancel()
+
+	policy := RetryPolicy{Retries: 1, Delay: time.Millisecond, MaxDelay: time.Minute}
+	assert.True(t, policy.waitForRetry(background, 0, &TemporaryError{}))
+	assert.False(t, policy.waitForRetry(background, 1, &TemporaryError{}))
+	assert.False(t, policy.waitForRetry(withDeadline, 0, &TemporaryError{RetryAfter: time.Hour}))
+	assert.False(t, policy.waitForRetry(withDeadline, 0, &TemporaryError{RetryAfter: time.Second}))
+	assert.False(t, RetryPolicy{}.waitForRetry(background, 0, &TemporaryError{}))
+}
+
+func TestRetryPolicy_MaxDelay(t *testing.T) {
+	// the suggested wait time is shortened to the MaxDelay
+	policy := RetryPolicy{Retries: 1, Delay: time.Millisecond, MaxDelay: 10 * time.Millisecond}
+	start := time.Now()
+	assert.True(t, policy.waitForRetry(context.Background(), 0, &TemporaryError{RetryAfter: time.Hour}))
+	assert.WithinDuration(t, start.Add(10*time.Millisecond), time.Now(), 50*time.Millisecond)
+	assert.True(t, time.Since(start) >= 10*time.Millisecond)
+
+	// the shortened wait time has to fit into the deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	assert.False(t, policy.waitForRetry(ctx, 0, &TemporaryError{RetryAfter: time.Hour}))
+}
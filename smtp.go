@@ -0,0 +1,188 @@
+package mailck
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// smtpClient is a minimal SMTP client for the mailbox check.
+// In contrast to net/smtp, it records all commands and replies of the conversation.
+type smtpClient struct {
+	conn     net.Conn
+	text     *textproto.Conn
+	host     string
+	ext      map[string]string
+	rec      *recorder
+	tlsState *tls.ConnectionState
+}
+
+// newSMTPClient reads the greeting of the mailserver.
+// The connection is closed, if the greeting fails.
+// Like all methods of the client, it returns errors as *SMTPError.
+func newSMTPClient(conn net.Conn, host string, rec *recorder) (*smtpClient, error) {
+	c := &smtpClient{
+		conn: conn,
+		text: textproto.NewConn(conn),
+		host: host,
+		rec:  rec,
+	}
+	if _, _, err := c.reply("", 220); err != nil {
+		c.text.Close()
+		return nil, c.wrapError(PhaseGreeting, err)
+	}
+	return c, nil
+}
+
+// cmd sends a command and reads the reply.
+// The error is a *textproto.Error, if the reply code does not match expectCode.
+func (c *smtpClient) cmd(expectCode int, format string, args ...interface{}) (code int, msg string, err error) {
+	command := fmt.Sprintf(format, args...)
+	id, err := c.text.Cmd("%s", command)
+	if err != nil {
+		c.rec.exchange(c.host, command, 0, err.Error())
+		return 0, "", err
+	}
+	c.text.StartResponse(id)
+	defer c.text.EndResponse(id)
+	return c.reply(command, expectCode)
+}
+
+func (c *smtpClient) reply(command string, expectCode int) (code int, msg string, err error) {
+	code, msg, err = c.text.ReadResponse(expectCode)
+	if code == 0 && err != nil {
+		msg = err.Error()
+	}
+	c.rec.exchange(c.host, command, code, msg)
+	return code, msg, err
+}
+
+// wrapError returns err as *SMTPError for the phase.
+func (c *smtpClient) wrapError(phase Phase, err error) error {
+	if err == nil {
+		return nil
+	}
+	return newSMTPError(c.host, phase, err)
+}
+
+// hello sends EHLO and falls back to HELO, if the mailserver does not know EHLO.
+func (c *smtpClient) hello(name string) error {
+	return c.wrapError(PhaseHelo, c.ehlo(name))
+}
+
+func (c *smtpClient) ehlo(name string) error {
+	code, msg, err := c.cmd(250, "EHLO %s", name)
+	if err != nil {
+		if code/100 != 5 {
+			return err
+		}
+		_, _, err = c.cmd(250, "HELO %s", name)
+		return err
+	}
+	c.ext = parseExtensions(msg)
+	return nil
+}
+
+// parseExtensions returns the keywords of an EHLO reply with their parameters.
+func parseExtensions(msg string) map[string]string {
+	ext := map[string]string{}
+	lines := strings.Split(msg, "\n")
+	for _, line := range lines[1:] {
+		kv := strings.SplitN(strings.TrimSpace(line), " ", 2)
+		if kv[0] == "" {
+			continue
+		}
+		params := ""
+		if len(kv) > 1 {
+			params = kv[1]
+		}
+		ext[strings.ToUpper(kv[0])] = params
+	}
+	return ext
+}
+
+// extension returns true, if the mailserver advertised the extension, together with its parameters.
+func (c *smtpClient) extension(name string) (bool, string) {
+	params, ok := c.ext[strings.ToUpper(name)]
+	return ok, params
+}
+
+// startTLS upgrades the connection and sends EHLO again.
+func (c *smtpClient) startTLS(config *tls.Config, heloName string) error {
+	if _, _, err := c.cmd(220, "STARTTLS"); err != nil {
+		return c.wrapError(PhaseStartTLS, err)
+	}
+	tlsConn := tls.Client(c.conn, config)
+	if err := tlsConn.Handshake(); err != nil {
+		return c.wrapError(PhaseStartTLS, err)
+	}
+	state := tlsConn.ConnectionState()
+	c.tlsState = &state
+	c.conn = tlsConn
+	c.text = textproto.NewConn(tlsConn)
+	c.ext = nil
+	return c.wrapError(PhaseStartTLS, c.ehlo(heloName))
+}
+
+// setDeadline limits the next commands. The zero value means no deadline.
+func (c *smtpClient) setDeadline(t time.Time) {
+	c.conn.SetDeadline(t)
+}
+
+// phaseDeadline returns the deadline for a phase of the conversation,
+// which is limited by the timeout of the phase and by the deadline of the context.
+// The zero time is returned, if neither is set.
+func phaseDeadline(ctx context.Context, timeout time.Duration) time.Time {
+	deadline, _ := ctx.Deadline()
+	if timeout > 0 {
+		if t := time.Now().Add(timeout); deadline.IsZero() || t.Before(deadline) {
+			deadline = t
+		}
+	}
+	return deadline
+}
+
+// mail sends a MAIL FROM command with optional parameters like SMTPUTF8.
+func (c *smtpClient) mail(from string, params ...string) error {
+	command := fmt.Sprintf("MAIL FROM:<%s>", from)
+	for _, param := range params {
+		command += " " + param
+	}
+	if err := validateLine(command); err != nil {
+		return c.wrapError(PhaseMailFrom, err)
+	}
+	_, _, err := c.cmd(250, "%s", command)
+	return c.wrapError(PhaseMailFrom, err)
+}
+
+// rcpt sends a RCPT TO command and returns the reply code and text.
+// The error is set for all codes other than 25x.
+func (c *smtpClient) rcpt(to string) (code int, msg string, err error) {
+	if err := validateLine(to); err != nil {
+		return 0, "", c.wrapError(PhaseRcptTo, err)
+	}
+	code, msg, err = c.cmd(25, "RCPT TO:<%s>", to)
+	return code, msg, c.wrapError(PhaseRcptTo, err)
+}
+
+// validateLine rejects arguments with CR or LF like net/smtp, so that no commands can be injected.
+func validateLine(line string) error {
+	if strings.ContainsAny(line, "\r\n") {
+		return errors.New("smtp: a line must not contain CR or LF")
+	}
+	return nil
+}
+
+func (c *smtpClient) quit() error {
+	_, _, err := c.cmd(221, "QUIT")
+	return err
+}
+
+func (c *smtpClient) close() error {
+	return c.text.Close()
+}
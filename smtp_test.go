@@ -0,0 +1,59 @@
+package mailck
+
+import (
+	"context"
+	"errors"
+	"github.com/siebenmann/smtpd"
+	"github.com/stretchr/testify/assert"
+	"net"
+	"testing"
+)
+
+func Test_parseExtensions(t *testing.T) {
+	ext := parseExtensions("mx.example.com Hello\nPIPELINING\nSIZE 10240000\nstarttls\n8BITMIME")
+	assert.Equal(t, map[string]string{
+		"PIPELINING": "",
+		"SIZE":       "10240000",
+		"STARTTLS":   "",
+		"8BITMIME":   "",
+	}, ext)
+
+	assert.Empty(t, parseExtensions("mx.example.com"))
+}
+
+func Test_smtpClient_extension(t *testing.T) {
+	c := &smtpClient{ext: parseExtensions("mx\nSIZE 100")}
+	ok, params := c.extension("size")
+	assert.True(t, ok)
+	assert.Equal(t, "100", params)
+
+	ok, _ = c.extension("STARTTLS")
+	assert.False(t, ok)
+}
+
+func Test_checkMailbox_RejectsLineBreaks(t *testing.T) {
+	dummyServer := NewDummySMTPServer("localhost:2525", smtpd.NOOP, false, 0)
+	defer dummyServer.Close()
+
+	tests := []struct {
+		fromEmail string
+		mail      string
+		phase     Phase
+	}{
+		{"noreply@mancke.net", "foo@bar.de>\r\nRCPT TO:<bar@bar.de", PhaseRcptTo},
+		{"noreply@mancke.net", "foo@bar.de\n", PhaseRcptTo},
+		{"noreply@mancke.net>\r\nRSET\r\nMAIL FROM:<spoofed@example.com", "foo@bar.de", PhaseMailFrom},
+	}
+	for _, test := range tests {
+		t.Run(test.mail, func(t *testing.T) {
+			c := NewChecker(WithFromEmail(test.fromEmail), WithHeloName("mancke.net"), WithSMTPPort(2525))
+			result, err := c.checkMailbox(context.Background(), test.mail, []*net.MX{{Host: "localhost"}}, newRecorder(test.mail))
+			assert.Equal(t, MailserverError, result)
+			var smtpErr *SMTPError
+			if assert.True(t, errors.As(err, &smtpErr)) {
+				assert.Equal(t, test.phase, smtpErr.Stage)
+				assert.Equal(t, 0, smtpErr.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package mailck
+
+import (
+	"golang.org/x/net/publicsuffix"
+	"strings"
+)
+
+// SuggestionDomains are the domains of popular mailbox providers, which are suggested for mistyped domains.
+// They are ordered by popularity, which decides between equally close matches.
+// The list may be extended before the first check.
+var SuggestionDomains = []string{
+	"gmail.com", "yahoo.com", "hotmail.com", "outlook.com", "icloud.com", "aol.com", "live.com", "msn.com",
+	"gmx.de", "web.de", "t-online.de", "googlemail.com", "hotmail.de", "outlook.de", "yahoo.de", "live.de",
+	"gmx.net", "gmx.at", "gmx.ch", "freenet.de", "arcor.de", "posteo.de", "mailbox.org", "online.de",
+	"vodafone.de", "kabelmail.de", "bluewin.ch", "hotmail.co.uk", "yahoo.co.uk", "hotmail.fr", "yahoo.fr",
+	"orange.fr", "laposte.net", "libero.it", "mail.ru", "yandex.ru", "comcast.net", "me.com", "mac.com",
+	"ymail.com", "mail.com", "email.com", "protonmail.com", "proton.me", "zoho.com",
+}
+
+// SuggestionTopLevelDomains are the top level domains, which are suggested for mistyped top level domains.
+// They are ordered by popularity, which decides between equally close matches.
+// The list may be extended before the first check.
+var SuggestionTopLevelDomains = []string{
+	"com", "net", "org", "de", "co.uk", "uk", "fr", "it", "es", "nl", "be", "at", "ch", "eu", "info", "biz",
+	"io", "ru", "pl", "se", "dk", "us",
+}
+
+// Suggest returns a corrected address, if the domain of the address looks like a typo
+// of a popular mailbox provider or top level domain, e.g. foo@gmail.com for foo@gmial.com.
+// Only the name in front of the public suffix is compared with the mailbox providers,
+// so that valid domains of other countries like yahoo.co.jp are not corrected.
+func Suggest(checkEmail string) (string, bool) {
+	at := strings.LastIndex(checkEmail, "@")
+	if at == -1 {
+		return "", false
+	}
+	localPart, domain := checkEmail[:at], strings.ToLower(checkEmail[at+1:])
+	if localPart == "" || domain == "" {
+		return "", false
+	}
+
+	corrected := domain
+	if _, icann := publicsuffix.PublicSuffix(domain); !icann {
+		var ok bool
+		if corrected, ok = suggestTopLevelDomain(domain); !ok {
+			return "", false
+		}
+	}
+	if provider, ok := suggestProvider(corrected); ok {
+		corrected = provider
+	}
+	if corrected == domain {
+		return "", false
+	}
+	return localPart + "@" + corrected, true
+}
+
+// suggestProvider returns the mailbox provider with the same public suffix, whose name is close
+// to the name of the domain, e.g. gmail.com for gmial.com.
+// Otherwise, it returns the provider with the same name, whose suffix was cut off, e.g. yahoo.com for yahoo.co.
+func suggestProvider(domain string) (string, bool) {
+	name, suffix, ok := splitSuffix(domain)
+	if !ok {
+		return "", false
+	}
+	var names []string
+	for _, provider := range SuggestionDomains {
+		if providerName, providerSuffix, ok := splitSuffix(provider); ok && providerSuffix == suffix {
+			names = append(names, providerName)
+		}
+	}
+	if closest, ok := closestMatch(name, names, maxSuggestionDistance(name)); ok {
+		return closest + "." + suffix, true
+	}
+	for _, provider := range SuggestionDomains {
+		providerName, providerSuffix, ok := splitSuffix(provider)
+		if ok && providerName == name && strings.HasPrefix(providerSuffix, suffix) {
+			return provider, true
+		}
+	}
+	return "", false
+}
+
+// splitSuffix splits a registered domain like gmail.co.uk into the name gmail and the public suffix co.uk.
+// Subdomains and domains with suffixes, which are not in the ICANN section of the public suffix list, are not split.
+func splitSuffix(domain string) (name, suffix string, ok bool) {
+	suffix, icann := publicsuffix.PublicSuffix(domain)
+	if !icann || !strings.HasSuffix(domain, "."+suffix) {
+		return "", "", false
+	}
+	name = strings.TrimSuffix(domain, "."+suffix)
+	return name, suffix, name != "" && !strings.Contains(name, ".")
+}
+
+// suggestTopLevelDomain returns the domain with a corrected top level domain, e.g. example.com for example.con.
+// The top level domain may consist of two labels like co.uk
+func suggestTopLevelDomain(domain string) (string, bool) {
+	dot := strings.Index(domain, ".")
+	if dot == -1 {
+		return "", false
+	}
+	for i := dot; i != -1; i = nextDot(domain, i) {
+		name, tld := domain[:i], domain[i+1:]
+		if closest, ok := closestMatch(tld, SuggestionTopLevelDomains, 1); ok {
+			if closest == tld {
+				return "", false
+			}
+			return name + "." + closest, true
+		}
+	}
+	return "", false
+}
+
+func nextDot(s string, i int) int {
+	next := strings.Index(s[i+1:], ".")
+	if next == -1 {
+		return -1
+	}
+	return i + 1 + next
+}
+
+// maxSuggestionDistance allows one typo in short names and two typos in longer ones.
+func maxSuggestionDistance(name string) int {
+	if len(name) < 8 {
+		return 1
+	}
+	return 2
+}
+
+// closestMatch returns the candidate with the smallest edit distance to s, if it is within maxDistance.
+func closestMatch(s string, candidates []string, maxDistance int) (string, bool) {
+	best, bestDistance := "", maxDistance+1
+	for _, candidate := range candidates {
+		if d := editDistance(s, candidate); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+	return best, best != ""
+}
+
+// editDistance returns the Damerau-Levenshtein distance (optimal string alignment) between a and b,
+// so that swapped letters like in gmial count as one edit.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	d := make([][]int, len(ra)+1)
+	for i := range d {
+		d[i] = make([]int, len(rb)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(ra)][len(rb)]
+}
@@ -0,0 +1,85 @@
+package mailck
+
+import (
+	"context"
+	"github.com/siebenmann/smtpd"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSuggest(t *testing.T) {
+	tests := []struct {
+		address    string
+		suggestion string
+	}{
+		{"foo@gmial.com", "foo@gmail.com"},
+		{"foo@gmai.com", "foo@gmail.com"},
+		{"Foo.Bar@GMAIL.CMO", "Foo.Bar@gmail.com"},
+		{"foo@hotmial.de", "foo@hotmail.de"},
+		{"foo@yahoo.co", "foo@yahoo.com"},
+		{"foo@gmial.cmo", "foo@gmail.com"},
+		{"foo@gmx.dee", "foo@gmx.de"},
+		{"foo@example.con", "foo@example.com"},
+		{"foo@example.co.uj", "foo@example.co.uk"},
+		{"foo@mail.example.nte", "foo@mail.example.net"},
+		{"foo@gmail.com", ""},
+		{"foo@gmx.at", ""},
+		{"foo@example.com", ""},
+		{"foo@example.co.uk", ""},
+		{"foo@mancke.net", ""},
+		{"foo@tarent.de", ""},
+		{"foo@example.xyz", ""},
+		// valid domains of other countries are not corrected
+		{"foo@yahoo.co.jp", ""},
+		{"foo@hotmail.it", ""},
+		{"foo@yahoo.ca", ""},
+		{"foo@outlook.es", ""},
+		{"foo@yahoo.es", ""},
+		{"foo@email.com", ""},
+		{"foo@mail.gmial.com", ""},
+		{"foo@localhost", ""},
+		{"xxx", ""},
+		{"foo@", ""},
+		{"@gmial.com", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.address, func(t *testing.T) {
+			suggestion, ok := Suggest(test.address)
+			assert.Equal(t, test.suggestion, suggestion)
+			assert.Equal(t, test.suggestion != "", ok)
+		})
+	}
+}
+
+func Test_editDistance(t *testing.T) {
+	assert.Equal(t, 0, editDistance("gmail", "gmail"))
+	assert.Equal(t, 1, editDistance("gmial", "gmail"))
+	assert.Equal(t, 1, editDistance("gmai", "gmail"))
+	assert.Equal(t, 2, editDistance("gnial", "gmail"))
+	assert.Equal(t, 3, editDistance("", "com"))
+	assert.Equal(t, 1, editDistance("müller", "muller"))
+}
+
+func TestChecker_Suggestion(t *testing.T) {
+	c := NewChecker(WithResolver(NewStaticResolver()))
+
+	report, err := c.CheckWithReport(context.Background(), "foo@gmial.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(InvalidDomain))
+	assert.Equal(t, "foo@gmail.com", report.Suggestion)
+
+	report, err = NewChecker(WithChecks(SyntaxCheck)).CheckWithReport(context.Background(), "foo@gmail.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(SyntaxChecked))
+	assert.Empty(t, report.Suggestion)
+
+	// a mailbox accepted by the mailserver is not corrected
+	dummyServer := NewDummySMTPServer("localhost:2525", smtpd.NOOP, false, 0)
+	defer dummyServer.Close()
+	r := NewStaticResolver().AddMX("gmial.com", "localhost").AddHost("localhost", "127.0.0.1")
+	c = NewChecker(WithFromEmail("noreply@mancke.net"), WithHeloName("mancke.net"), WithResolver(r), WithSMTPPort(2525))
+	report, err = c.CheckWithReport(context.Background(), "foo@gmial.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Is(Valid))
+	assert.Empty(t, report.Suggestion)
+}
@@ -0,0 +1,98 @@
+package mailck
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"strings"
+)
+
+// TLSMode configures the use of STARTTLS in the SMTP conversation.
+type TLSMode int
+
+const (
+	// TLSDisabled talks plaintext only.
+	TLSDisabled TLSMode = iota
+	// TLSOpportunistic upgrades the connection, if the mailserver advertises STARTTLS.
+	TLSOpportunistic
+	// TLSRequired fails with TLSError, if the connection can't be upgraded.
+	TLSRequired
+)
+
+// TLSInfo describes the TLS state of the connection to the mailserver.
+type TLSInfo struct {
+	// Advertised is true, if the mailserver offered STARTTLS.
+	Advertised bool `json:"advertised"`
+	// Version is the negotiated TLS version, e.g. "TLS 1.3". It is empty, if TLS was not used.
+	Version string `json:"version,omitempty"`
+	// CipherSuite is the name of the negotiated cipher suite.
+	CipherSuite string `json:"cipherSuite,omitempty"`
+	// CertificateValid is true, if the certificate of the mailserver could be verified for its hostname.
+	CertificateValid bool `json:"certificateValid"`
+	// CertificateError contains the reason, if the certificate could not be verified.
+	CertificateError string `json:"certificateError,omitempty"`
+}
+
+// WithStartTLS enables STARTTLS in the mailbox check.
+// The config may be nil. The certificate of the mailserver is not enforced,
+// but its validity is recorded in the TLS field of the CheckReport.
+func WithStartTLS(mode TLSMode, config *tls.Config) Option {
+	return func(c *Checker) {
+		c.tlsMode = mode
+		c.tlsConfig = config
+	}
+}
+
+var errStartTLSNotAdvertised = errors.New("mailserver does not advertise STARTTLS")
+
+// startTLS upgrades the connection according to the TLS mode and returns the TLS state.
+// The client must have sent its HELO already.
+func (c *Checker) startTLS(client *smtpClient, host string) (*TLSInfo, error) {
+	info := &TLSInfo{}
+	info.Advertised, _ = client.extension("STARTTLS")
+	if !info.Advertised {
+		if c.tlsMode == TLSRequired {
+			return info, errStartTLSNotAdvertised
+		}
+		return info, nil
+	}
+
+	serverName := strings.TrimSuffix(host, ".")
+	config := &tls.Config{}
+	if c.tlsConfig != nil {
+		config = c.tlsConfig.Clone()
+	}
+	config.ServerName = serverName
+	// mailservers often use self signed certificates, so we only record the validity
+	config.InsecureSkipVerify = true
+
+	if err := client.startTLS(config, c.helo); err != nil {
+		return info, err
+	}
+
+	state := *client.tlsState
+	info.Version = tls.VersionName(state.Version)
+	info.CipherSuite = tls.CipherSuiteName(state.CipherSuite)
+	if err := verifyCertificate(state, serverName, config.RootCAs); err != nil {
+		info.CertificateError = err.Error()
+	} else {
+		info.CertificateValid = true
+	}
+	return info, nil
+}
+
+func verifyCertificate(state tls.ConnectionState, serverName string, roots *x509.CertPool) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("no certificate presented")
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range state.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+		DNSName:       serverName,
+		Roots:         roots,
+		Intermediates: intermediates,
+	})
+	return err
+}
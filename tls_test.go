@@ -0,0 +1,152 @@
+package mailck
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestChecker_StartTLS(t *testing.T) {
+	cert, roots := selfSignedCertificate(t, "localhost")
+
+	tests := []struct {
+		title     string
+		mode      TLSMode
+		config    *tls.Config
+		advertise bool
+		result    Result
+		tls       *TLSInfo
+	}{
+		{"disabled", TLSDisabled, nil, true, Valid, nil},
+		{"opportunistic, not advertised", TLSOpportunistic, nil, false, Valid, &TLSInfo{}},
+		{"required, not advertised", TLSRequired, nil, false, TLSError, &TLSInfo{}},
+		{"opportunistic, unknown certificate", TLSOpportunistic, nil, true, Valid, &TLSInfo{Advertised: true, Version: "TLS 1.3"}},
+		{"required, valid certificate", TLSRequired, &tls.Config{RootCAs: roots}, true, Valid, &TLSInfo{Advertised: true, Version: "TLS 1.3", CertificateValid: true}},
+	}
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			port := startTLSTestServer(t, cert, test.advertise)
+			checker := NewChecker(WithFromEmail("noreply@mancke.net"), WithHeloName("mancke.net"), WithSMTPPort(port), WithStartTLS(test.mode, test.config))
+
+			rec := newRecorder("foo@bar.de")
+			result, err := checker.checkMailbox(context.Background(), "foo@bar.de", []*net.MX{{Host: "localhost"}}, rec)
+			assert.True(t, result.Is(test.result), "unexpected result %v", result.ResultDetail)
+			if test.result == Valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+			if test.tls == nil {
+				assert.Nil(t, rec.report.TLS)
+				return
+			}
+			if assert.NotNil(t, rec.report.TLS) {
+				assert.Equal(t, test.tls.Advertised, rec.report.TLS.Advertised)
+				assert.Equal(t, test.tls.Version, rec.report.TLS.Version)
+				assert.Equal(t, test.tls.CertificateValid, rec.report.TLS.CertificateValid)
+				assert.Equal(t, test.tls.Version != "" && !test.tls.CertificateValid, rec.report.TLS.CertificateError != "")
+				assert.Equal(t, test.tls.Version != "", rec.report.TLS.CipherSuite != "")
+			}
+		})
+	}
+}
+
+// startTLSTestServer starts an SMTP server, which accepts all commands and optionally supports STARTTLS.
+// It returns the port of the server.
+func startTLSTestServer(t *testing.T, cert tls.Certificate, advertise bool) int {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveTLSTestConn(conn, cert, advertise)
+		}
+	}()
+	return ln.Addr().(*net.TCPAddr).Port
+}
+
+func serveTLSTestConn(conn net.Conn, cert tls.Certificate, advertise bool) {
+	defer func() { conn.Close() }()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	r := bufio.NewReader(conn)
+	reply := func(lines ...string) {
+		for _, line := range lines {
+			conn.Write([]byte(line + "\r\n"))
+		}
+	}
+	reply("220 localhost ESMTP test")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		switch cmd := strings.ToUpper(strings.TrimSpace(line)); {
+		case strings.HasPrefix(cmd, "EHLO"):
+			if advertise {
+				reply("250-localhost", "250 STARTTLS")
+			} else {
+				reply("250 localhost")
+			}
+		case cmd == "STARTTLS":
+			reply("220 2.0.0 Ready to start TLS")
+			tlsConn := tls.Server(conn, &tls.Config{Certificates: []tls.Certificate{cert}})
+			if tlsConn.Handshake() != nil {
+				return
+			}
+			conn = tlsConn
+			r = bufio.NewReader(conn)
+		case cmd == "QUIT":
+			reply("221 2.0.0 Bye")
+			return
+		default:
+			reply("250 2.0.0 Ok")
+		}
+	}
+}
+
+func selfSignedCertificate(t *testing.T, host string) (tls.Certificate, *x509.CertPool) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: host},
+		DNSNames:              []string{host},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(parsed)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, roots
+}